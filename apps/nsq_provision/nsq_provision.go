@@ -0,0 +1,210 @@
+// This is a utility application that diffs a declarative spec of topics and
+// channels against a cluster and prints, or with --apply makes, the changes
+
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/nsqio/nsq/internal/app"
+	"github.com/nsqio/nsq/internal/clusterinfo"
+	"github.com/nsqio/nsq/internal/http_api"
+	"github.com/nsqio/nsq/internal/version"
+)
+
+var (
+	showVersion           = flag.Bool("version", false, "print version")
+	logFormat             = flag.String("log-format", "text", "log output format: text or json")
+	specFile              = flag.String("spec", "", "path to the JSON spec of topics and channels (- for stdin)")
+	apply                 = flag.Bool("apply", false, "make the planned changes (by default they are only printed)")
+	prune                 = flag.Bool("prune", false, "delete the channels of the spec's topics which it doesn't declare")
+	httpConnectTimeout    = flag.Duration("http-client-connect-timeout", 2*time.Second, "timeout for HTTP connect")
+	httpRequestTimeout    = flag.Duration("http-client-request-timeout", 5*time.Second, "timeout for HTTP request")
+	tlsInsecureSkipVerify = flag.Bool("http-client-tls-insecure-skip-verify", false, "configure the HTTP client to skip verification of TLS certificates")
+	tlsRootCAFile         = flag.String("http-client-tls-root-ca-file", "", "path to CA file for the HTTP client")
+	tlsCert               = flag.String("http-client-tls-cert", "", "path to certificate file for the HTTP client")
+	tlsKey                = flag.String("http-client-tls-key", "", "path to key file for the HTTP client")
+	authSecret            = flag.String("http-client-auth-secret", "", "secret presented to nsqd and nsqlookupd that require auth")
+	nsqdHTTPAddrs         = app.StringArray{}
+	lookupdHTTPAddrs      = app.StringArray{}
+)
+
+func init() {
+	flag.Var(&nsqdHTTPAddrs, "nsqd-http-address", "nsqd HTTP address (may be given multiple times)")
+	flag.Var(&lookupdHTTPAddrs, "lookupd-http-address", "lookupd HTTP address (may be given multiple times)")
+}
+
+func checkAddrs(addrs []string) error {
+	for _, a := range addrs {
+		if strings.HasPrefix(a, "http") {
+			return errors.New("address should not contain scheme")
+		}
+	}
+	return nil
+}
+
+func newTLSConfig() (*tls.Config, error) {
+	if *tlsCert != "" && *tlsKey == "" {
+		return nil, errors.New("--http-client-tls-key must be specified with --http-client-tls-cert")
+	}
+	if *tlsKey != "" && *tlsCert == "" {
+		return nil, errors.New("--http-client-tls-cert must be specified with --http-client-tls-key")
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: *tlsInsecureSkipVerify,
+	}
+	if *tlsCert != "" {
+		cert, err := tls.LoadX509KeyPair(*tlsCert, *tlsKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to LoadX509KeyPair %s, %s - %s", *tlsCert, *tlsKey, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	if *tlsRootCAFile != "" {
+		tlsCertPool := x509.NewCertPool()
+		caCertFile, err := ioutil.ReadFile(*tlsRootCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read TLS root CA file %s - %s", *tlsRootCAFile, err)
+		}
+		if !tlsCertPool.AppendCertsFromPEM(caCertFile) {
+			return nil, fmt.Errorf("failed to AppendCertsFromPEM %s", *tlsRootCAFile)
+		}
+		tlsConfig.RootCAs = tlsCertPool
+	}
+	return tlsConfig, nil
+}
+
+func readSpec(fileName string) (*clusterinfo.Spec, error) {
+	var data []byte
+	var err error
+	if fileName == "-" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(fileName)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return clusterinfo.ParseSpec(data)
+}
+
+func changePrefix(action string) string {
+	switch action {
+	case clusterinfo.SpecCreateTopic, clusterinfo.SpecCreateChannel:
+		return "+"
+	case clusterinfo.SpecDeleteChannel:
+		return "-"
+	}
+	return "~"
+}
+
+func main() {
+	flag.Parse()
+
+	if *showVersion {
+		fmt.Printf("nsq_provision v%s\n", version.Binary)
+		return
+	}
+
+	_, err := app.SetLogFormat(*logFormat, "nsq_provision")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *specFile == "" {
+		log.Fatal("--spec is required")
+	}
+
+	connectTimeout := *httpConnectTimeout
+	if int64(connectTimeout) <= 0 {
+		log.Fatal("--http-client-connect-timeout should be positive")
+	}
+
+	requestTimeout := *httpRequestTimeout
+	if int64(requestTimeout) <= 0 {
+		log.Fatal("--http-client-request-timeout should be positive")
+	}
+
+	if len(nsqdHTTPAddrs) == 0 && len(lookupdHTTPAddrs) == 0 {
+		log.Fatal("--nsqd-http-address or --lookupd-http-address required")
+	}
+	if len(nsqdHTTPAddrs) > 0 && len(lookupdHTTPAddrs) > 0 {
+		log.Fatal("use --nsqd-http-address or --lookupd-http-address not both")
+	}
+
+	if err := checkAddrs(nsqdHTTPAddrs); err != nil {
+		log.Fatalf("--nsqd-http-address error - %s", err)
+	}
+
+	if err := checkAddrs(lookupdHTTPAddrs); err != nil {
+		log.Fatalf("--lookupd-http-address error - %s", err)
+	}
+
+	tlsConfig, err := newTLSConfig()
+	if err != nil {
+		log.Fatalf("ERROR: failed to build TLS config - %s", err)
+	}
+
+	spec, err := readSpec(*specFile)
+	if err != nil {
+		log.Fatalf("ERROR: failed to read spec %s - %s", *specFile, err)
+	}
+
+	ci := clusterinfo.New(nil, http_api.NewClient(tlsConfig, connectTimeout, requestTimeout))
+	if *authSecret != "" {
+		ci = ci.WithAuthSecret(*authSecret)
+	}
+	state, err := ci.GetSpecState(lookupdHTTPAddrs, nsqdHTTPAddrs)
+	if err != nil {
+		// a plan from a partial state is still useful to look at, but
+		// applying it could create or delete what is merely unreachable
+		_, ok := err.(clusterinfo.PartialErr)
+		if !ok || *apply {
+			log.Fatalf("ERROR: failed to get cluster state - %s", err)
+		}
+		log.Printf("WARNING: cluster state is incomplete - %s", err)
+	}
+
+	changes := spec.Plan(state, *prune)
+	if len(changes) == 0 {
+		fmt.Println("no changes")
+		return
+	}
+	for _, change := range changes {
+		fmt.Printf("%s %s\n", changePrefix(change.Action), change)
+	}
+	if !*apply {
+		return
+	}
+
+	applied, failed := 0, 0
+	for _, change := range changes {
+		if change.Skipped != "" {
+			continue
+		}
+		err := ci.ApplySpecChange(change, lookupdHTTPAddrs, nsqdHTTPAddrs)
+		if err != nil {
+			log.Printf("ERROR: failed to %s - %s", change, err)
+			failed++
+			continue
+		}
+		applied++
+	}
+	if failed > 0 {
+		log.Fatalf("ERROR: %d of %d changes failed", failed, applied+failed)
+	}
+	fmt.Printf("applied %d changes\n", applied)
+	if skipped := len(changes) - applied; skipped > 0 {
+		fmt.Printf("skipped %d changes\n", skipped)
+	}
+}
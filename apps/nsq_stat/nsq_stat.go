@@ -23,6 +23,7 @@ import (
 
 var (
 	showVersion        = flag.Bool("version", false, "print version")
+	logFormat          = flag.String("log-format", "text", "log output format: text or json")
 	topic              = flag.String("topic", "", "NSQ topic")
 	channel            = flag.String("channel", "", "NSQ channel")
 	interval           = flag.Duration("interval", 2*time.Second, "duration of time between polling/printing output")
@@ -137,6 +138,11 @@ func main() {
 		return
 	}
 
+	_, err := app.SetLogFormat(*logFormat, "nsq_stat")
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	if *topic == "" || *channel == "" {
 		log.Fatal("--topic and --channel are required")
 	}
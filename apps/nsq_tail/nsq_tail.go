@@ -17,6 +17,7 @@ import (
 
 var (
 	showVersion = flag.Bool("version", false, "print version string")
+	logFormat   = flag.String("log-format", "text", "log output format: text or json")
 
 	channel       = flag.String("channel", "", "NSQ channel")
 	maxInFlight   = flag.Int("max-in-flight", 200, "max number of messages to allow in flight")
@@ -79,6 +80,11 @@ func main() {
 		return
 	}
 
+	nsqLogger, err := app.SetLogFormat(*logFormat, "nsq_tail")
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	if *channel == "" {
 		rand.Seed(time.Now().UnixNano())
 		*channel = fmt.Sprintf("tail%06d#ephemeral", rand.Int()%999999)
@@ -113,6 +119,9 @@ func main() {
 		if err != nil {
 			log.Fatal(err)
 		}
+		if nsqLogger != nil {
+			consumer.SetLogger(nsqLogger, nsq.LogLevelInfo)
+		}
 
 		consumer.AddHandler(&TailHandler{topicName: topics[i], totalMessages: *totalMessages})
 
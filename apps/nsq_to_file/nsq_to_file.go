@@ -34,6 +34,7 @@ func flagSet() *flag.FlagSet {
 	fs.Bool("version", false, "print version string")
 	fs.String("log-level", "info", "set log verbosity: debug, info, warn, error, or fatal")
 	fs.String("log-prefix", "[nsq_to_file] ", "log message prefix")
+	fs.String("log-format", "text", "log output format: text or json")
 
 	fs.String("channel", "nsq_to_file", "nsq channel")
 	fs.Int("max-in-flight", 200, "max number of messages to allow in flight")
@@ -79,7 +80,14 @@ func main() {
 	opts := NewOptions()
 	options.Resolve(opts, fs, nil)
 
-	logger := log.New(os.Stderr, opts.LogPrefix, log.Ldate|log.Ltime|log.Lmicroseconds)
+	var logger lg.Logger = log.New(os.Stderr, opts.LogPrefix, log.Ldate|log.Ltime|log.Lmicroseconds)
+	jsonLogger, err := app.SetLogFormat(opts.LogFormat, "nsq_to_file")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if jsonLogger != nil {
+		logger = jsonLogger
+	}
 	logLevel, err := lg.ParseLogLevel(opts.LogLevel)
 	if err != nil {
 		log.Fatal("--log-level is invalid")
@@ -17,6 +17,7 @@ type Options struct {
 
 	LogPrefix      string        `flag:"log-prefix"`
 	LogLevel       string        `flag:"log-level"`
+	LogFormat      string        `flag:"log-format"`
 	OutputDir      string        `flag:"output-dir"`
 	WorkDir        string        `flag:"work-dir"`
 	DatetimeFormat string        `flag:"datetime-format"`
@@ -34,6 +35,7 @@ func NewOptions() *Options {
 	return &Options{
 		LogPrefix:                "[nsq_to_file] ",
 		LogLevel:                 "info",
+		LogFormat:                "text",
 		Channel:                  "nsq_to_file",
 		MaxInFlight:              200,
 		OutputDir:                "/tmp",
@@ -15,7 +15,7 @@ func init() {
 	userAgent = fmt.Sprintf("nsq_to_http v%s", version.Binary)
 }
 
-func HTTPGet(endpoint string) (*http.Response, error) {
+func HTTPGet(endpoint string, header http.Header) (*http.Response, error) {
 	req, err := http.NewRequest("GET", endpoint, nil)
 	if err != nil {
 		return nil, err
@@ -24,10 +24,13 @@ func HTTPGet(endpoint string) (*http.Response, error) {
 	for key, val := range validCustomHeaders {
 		req.Header.Set(key, val)
 	}
+	for key, vals := range header {
+		req.Header[key] = vals
+	}
 	return httpclient.Do(req)
 }
 
-func HTTPPost(endpoint string, body *bytes.Buffer) (*http.Response, error) {
+func HTTPPost(endpoint string, body *bytes.Buffer, header http.Header) (*http.Response, error) {
 	req, err := http.NewRequest("POST", endpoint, body)
 	if err != nil {
 		return nil, err
@@ -37,5 +40,8 @@ func HTTPPost(endpoint string, body *bytes.Buffer) (*http.Response, error) {
 	for key, val := range validCustomHeaders {
 		req.Header.Set(key, val)
 	}
+	for key, vals := range header {
+		req.Header[key] = vals
+	}
 	return httpclient.Do(req)
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/nsqio/go-nsq"
 	"github.com/nsqio/nsq/internal/app"
 	"github.com/nsqio/nsq/internal/http_api"
+	"github.com/nsqio/nsq/internal/tracing"
 	"github.com/nsqio/nsq/internal/version"
 )
 
@@ -36,6 +37,7 @@ const (
 
 var (
 	showVersion = flag.Bool("version", false, "print version string")
+	logFormat   = flag.String("log-format", "text", "log output format: text or json")
 
 	topic       = flag.String("topic", "", "nsq topic")
 	channel     = flag.String("channel", "nsq_to_http", "nsq channel")
@@ -66,7 +68,7 @@ func init() {
 }
 
 type Publisher interface {
-	Publish(string, []byte) error
+	Publish(string, []byte, http.Header) error
 }
 
 type PublishHandler struct {
@@ -87,12 +89,22 @@ func (ph *PublishHandler) HandleMessage(m *nsq.Message) error {
 		return nil
 	}
 
+	// messages published with trace context carry it in a message header,
+	// strip that from the body and forward it as an HTTP header instead
+	body := m.Body
+	var header http.Header
+	if sc, rest, ok := tracing.SplitBody(m.Body); ok {
+		body = rest
+		header = http.Header{}
+		header.Set(tracing.TraceparentHeader, sc.Traceparent())
+	}
+
 	startTime := time.Now()
 	switch ph.mode {
 	case ModeAll:
 		for _, addr := range ph.addresses {
 			st := time.Now()
-			err := ph.Publish(addr, m.Body)
+			err := ph.Publish(addr, body, header)
 			if err != nil {
 				return err
 			}
@@ -102,7 +114,7 @@ func (ph *PublishHandler) HandleMessage(m *nsq.Message) error {
 		counter := atomic.AddUint64(&ph.counter, 1)
 		idx := counter % uint64(len(ph.addresses))
 		addr := ph.addresses[idx]
-		err := ph.Publish(addr, m.Body)
+		err := ph.Publish(addr, body, header)
 		if err != nil {
 			return err
 		}
@@ -110,7 +122,7 @@ func (ph *PublishHandler) HandleMessage(m *nsq.Message) error {
 	case ModeHostPool:
 		hostPoolResponse := ph.hostPool.Get()
 		addr := hostPoolResponse.Host()
-		err := ph.Publish(addr, m.Body)
+		err := ph.Publish(addr, body, header)
 		hostPoolResponse.Mark(err)
 		if err != nil {
 			return err
@@ -124,9 +136,9 @@ func (ph *PublishHandler) HandleMessage(m *nsq.Message) error {
 
 type PostPublisher struct{}
 
-func (p *PostPublisher) Publish(addr string, msg []byte) error {
+func (p *PostPublisher) Publish(addr string, msg []byte, header http.Header) error {
 	buf := bytes.NewBuffer(msg)
-	resp, err := HTTPPost(addr, buf)
+	resp, err := HTTPPost(addr, buf, header)
 	if err != nil {
 		return err
 	}
@@ -141,9 +153,9 @@ func (p *PostPublisher) Publish(addr string, msg []byte) error {
 
 type GetPublisher struct{}
 
-func (p *GetPublisher) Publish(addr string, msg []byte) error {
+func (p *GetPublisher) Publish(addr string, msg []byte, header http.Header) error {
 	endpoint := fmt.Sprintf(addr, url.QueryEscape(string(msg)))
-	resp, err := HTTPGet(endpoint)
+	resp, err := HTTPGet(endpoint, header)
 	if err != nil {
 		return err
 	}
@@ -183,8 +195,12 @@ func main() {
 		return
 	}
 
+	nsqLogger, err := app.SetLogFormat(*logFormat, "nsq_to_http")
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	if len(customHeaders) > 0 {
-		var err error
 		validCustomHeaders, err = parseCustomHeaders(customHeaders)
 		if err != nil {
 			log.Fatal("--header value format should be 'key=value'")
@@ -254,6 +270,9 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	if nsqLogger != nil {
+		consumer.SetLogger(nsqLogger, nsq.LogLevelInfo)
+	}
 
 	perAddressStatus := make(map[string]*timer_metrics.TimerMetrics)
 	if len(addresses) == 1 {
@@ -12,6 +12,8 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"syscall"
 	"time"
@@ -20,6 +22,9 @@ import (
 	"github.com/bitly/timer_metrics"
 	"github.com/nsqio/go-nsq"
 	"github.com/nsqio/nsq/internal/app"
+	"github.com/nsqio/nsq/internal/clusterinfo"
+	"github.com/nsqio/nsq/internal/http_api"
+	"github.com/nsqio/nsq/internal/lg"
 	"github.com/nsqio/nsq/internal/protocol"
 	"github.com/nsqio/nsq/internal/version"
 )
@@ -31,6 +36,7 @@ const (
 
 var (
 	showVersion = flag.Bool("version", false, "print version string")
+	logFormat   = flag.String("log-format", "text", "log output format: text or json")
 	channel     = flag.String("channel", "nsq_to_nsq", "nsq channel")
 	destTopic   = flag.String("destination-topic", "", "use this destination topic for all consumed topics (default is consumed topic name)")
 	maxInFlight = flag.Int("max-in-flight", 200, "max number of messages to allow in flight")
@@ -38,11 +44,17 @@ var (
 	statusEvery = flag.Int("status-every", 250, "the # of requests between logging status (per destination), 0 disables")
 	mode        = flag.String("mode", "hostpool", "the upstream request mode options: round-robin, hostpool (default), epsilon-greedy")
 
-	nsqdTCPAddrs        = app.StringArray{}
-	lookupdHTTPAddrs    = app.StringArray{}
-	destNsqdTCPAddrs    = app.StringArray{}
-	whitelistJSONFields = app.StringArray{}
-	topics              = app.StringArray{}
+	httpConnectTimeout = flag.Duration("http-client-connect-timeout", 2*time.Second, "timeout for HTTP connect")
+	httpRequestTimeout = flag.Duration("http-client-request-timeout", 5*time.Second, "timeout for HTTP request")
+
+	destLookupdPollInterval = flag.Duration("destination-lookupd-poll-interval", time.Minute, "how often to refresh the destination nsqd from --destination-lookupd-http-address")
+
+	nsqdTCPAddrs         = app.StringArray{}
+	lookupdHTTPAddrs     = app.StringArray{}
+	destNsqdTCPAddrs     = app.StringArray{}
+	destLookupdHTTPAddrs = app.StringArray{}
+	whitelistJSONFields  = app.StringArray{}
+	topics               = app.StringArray{}
 
 	requireJSONField = flag.String("require-json-field", "", "for JSON messages: only pass messages that contain this field")
 	requireJSONValue = flag.String("require-json-value", "", "for JSON messages: only pass messages in which the required field has this value")
@@ -52,6 +64,7 @@ func init() {
 	flag.Var(&nsqdTCPAddrs, "nsqd-tcp-address", "nsqd TCP address (may be given multiple times)")
 	flag.Var(&destNsqdTCPAddrs, "destination-nsqd-tcp-address", "destination nsqd TCP address (may be given multiple times)")
 	flag.Var(&lookupdHTTPAddrs, "lookupd-http-address", "lookupd HTTP address (may be given multiple times)")
+	flag.Var(&destLookupdHTTPAddrs, "destination-lookupd-http-address", "destination lookupd HTTP address to discover the nsqd to publish to (may be given multiple times)")
 	flag.Var(&topics, "topic", "nsq topic (may be given multiple times)")
 	flag.Var(&whitelistJSONFields, "whitelist-json-field", "for JSON messages: pass this field (may be given multiple times)")
 }
@@ -60,12 +73,16 @@ type PublishHandler struct {
 	// 64bit atomic vars need to be first for proper alignment on 32bit platforms
 	counter uint64
 
+	sync.RWMutex
 	addresses app.StringArray
 	producers map[string]*nsq.Producer
 	mode      int
 	hostPool  hostpool.HostPool
 	respChan  chan *nsq.ProducerTransaction
 
+	cfg    *nsq.Config
+	logger lg.Logger
+
 	requireJSONValueParsed   bool
 	requireJSONValueIsNumber bool
 	requireJSONNumber        float64
@@ -115,11 +132,95 @@ func (ph *PublishHandler) responder() {
 			msg.Requeue(-1)
 		}
 
-		ph.perAddressStatus[address].Status(startTime)
+		ph.RLock()
+		status := ph.perAddressStatus[address]
+		ph.RUnlock()
+		status.Status(startTime)
 		ph.timermetrics.Status(startTime)
 	}
 }
 
+func newProducer(addr string, cfg *nsq.Config, logger lg.Logger) (*nsq.Producer, error) {
+	producer, err := nsq.NewProducer(addr, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if logger != nil {
+		producer.SetLogger(logger, nsq.LogLevelInfo)
+	}
+	return producer, nil
+}
+
+// setDestinations replaces the nsqd published to with addrs, connecting to
+// the new ones and stopping those no longer in the list
+func (ph *PublishHandler) setDestinations(addrs []string) error {
+	ph.Lock()
+	if strings.Join(addrs, ",") == strings.Join(ph.addresses, ",") {
+		ph.Unlock()
+		return nil
+	}
+
+	producers := make(map[string]*nsq.Producer, len(addrs))
+	for _, addr := range addrs {
+		producer, ok := ph.producers[addr]
+		if !ok {
+			var err error
+			producer, err = newProducer(addr, ph.cfg, ph.logger)
+			if err != nil {
+				ph.Unlock()
+				return err
+			}
+		}
+		producers[addr] = producer
+		if _, ok := ph.perAddressStatus[addr]; !ok {
+			ph.perAddressStatus[addr] = timer_metrics.NewTimerMetrics(*statusEvery,
+				fmt.Sprintf("[%s]:", addr))
+		}
+	}
+
+	var stale []*nsq.Producer
+	for addr, producer := range ph.producers {
+		if _, ok := producers[addr]; !ok {
+			stale = append(stale, producer)
+		}
+	}
+
+	ph.addresses = addrs
+	ph.producers = producers
+	ph.hostPool = newHostPool(addrs)
+	ph.Unlock()
+
+	// in flight publishes to a stopped producer fail and are requeued
+	for _, producer := range stale {
+		producer.Stop()
+	}
+	return nil
+}
+
+// refreshDestinations rediscovers the nsqd to publish to every interval
+func (ph *PublishHandler) refreshDestinations(topics []string, lookupdHTTPAddrs []string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		addrs, err := discoverDestinations(topics, lookupdHTTPAddrs)
+		if err != nil {
+			log.Printf("WARNING: failed to refresh destination nsqd - %s", err)
+			continue
+		}
+		err = ph.setDestinations(addrs)
+		if err != nil {
+			log.Printf("WARNING: failed to update destination nsqd - %s", err)
+		}
+	}
+}
+
+func newHostPool(addrs []string) hostpool.HostPool {
+	if *mode == "epsilon-greedy" {
+		return hostpool.NewEpsilonGreedy(addrs, 0, &hostpool.LinearEpsilonValueCalculator{})
+	}
+	return hostpool.New(addrs)
+}
+
 func (ph *PublishHandler) shouldPassMessage(js map[string]interface{}) (bool, bool) {
 	pass := true
 	backoff := false
@@ -231,6 +332,9 @@ func (ph *PublishHandler) HandleMessage(m *nsq.Message, destinationTopic string)
 
 	startTime := time.Now()
 
+	ph.RLock()
+	defer ph.RUnlock()
+
 	switch ph.mode {
 	case ModeRoundRobin:
 		counter := atomic.AddUint64(&ph.counter, 1)
@@ -254,6 +358,35 @@ func (ph *PublishHandler) HandleMessage(m *nsq.Message, destinationTopic string)
 	return nil
 }
 
+// discoverDestinations returns the nsqd suitable for publishing each of
+// topics according to the given nsqlookupd, best first
+func discoverDestinations(topics []string, lookupdHTTPAddrs []string) ([]string, error) {
+	ci := clusterinfo.New(nil, http_api.NewClient(nil, *httpConnectTimeout, *httpRequestTimeout))
+
+	var addrs []string
+	seen := make(map[string]bool)
+	for _, topic := range topics {
+		producers, err := ci.GetLookupdPublishers(topic, lookupdHTTPAddrs)
+		if err != nil {
+			if len(producers) == 0 {
+				return nil, err
+			}
+			log.Printf("WARNING: failed to query some nsqlookupd - %s", err)
+		}
+		for _, p := range producers {
+			addr := p.TCPAddress()
+			if !seen[addr] {
+				seen[addr] = true
+				addrs = append(addrs, addr)
+			}
+		}
+	}
+	if len(addrs) == 0 {
+		return nil, errors.New("no nsqd available to publish to")
+	}
+	return addrs, nil
+}
+
 func hasArg(s string) bool {
 	argExist := false
 	flag.Visit(func(f *flag.Flag) {
@@ -280,6 +413,11 @@ func main() {
 		return
 	}
 
+	nsqLogger, err := app.SetLogFormat(*logFormat, "nsq_to_nsq")
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	if len(topics) == 0 || *channel == "" {
 		log.Fatal("--topic and --channel are required")
 	}
@@ -305,8 +443,11 @@ func main() {
 		log.Fatal("use --nsqd-tcp-address or --lookupd-http-address not both")
 	}
 
-	if len(destNsqdTCPAddrs) == 0 {
-		log.Fatal("--destination-nsqd-tcp-address required")
+	if len(destNsqdTCPAddrs) == 0 && len(destLookupdHTTPAddrs) == 0 {
+		log.Fatal("--destination-nsqd-tcp-address or --destination-lookupd-http-address required")
+	}
+	if len(destNsqdTCPAddrs) > 0 && len(destLookupdHTTPAddrs) > 0 {
+		log.Fatal("use --destination-nsqd-tcp-address or --destination-lookupd-http-address not both")
 	}
 
 	switch *mode {
@@ -325,9 +466,22 @@ func main() {
 	cCfg.MaxInFlight = *maxInFlight
 	pCfg.UserAgent = defaultUA
 
+	publishTopics := topics
+	if *destTopic != "" {
+		publishTopics = app.StringArray{*destTopic}
+	}
+	if len(destLookupdHTTPAddrs) > 0 {
+		addrs, err := discoverDestinations(publishTopics, destLookupdHTTPAddrs)
+		if err != nil {
+			log.Fatalf("failed to discover destination nsqd - %s", err)
+		}
+		log.Printf("publishing to %s", strings.Join(addrs, ", "))
+		destNsqdTCPAddrs = addrs
+	}
+
 	producers := make(map[string]*nsq.Producer)
 	for _, addr := range destNsqdTCPAddrs {
-		producer, err := nsq.NewProducer(addr, pCfg)
+		producer, err := newProducer(addr, pCfg, nsqLogger)
 		if err != nil {
 			log.Fatalf("failed creating producer %s", err)
 		}
@@ -345,18 +499,15 @@ func main() {
 		}
 	}
 
-	hostPool := hostpool.New(destNsqdTCPAddrs)
-	if *mode == "epsilon-greedy" {
-		hostPool = hostpool.NewEpsilonGreedy(destNsqdTCPAddrs, 0, &hostpool.LinearEpsilonValueCalculator{})
-	}
-
 	var consumerList []*nsq.Consumer
 
 	publisher := &PublishHandler{
 		addresses:        destNsqdTCPAddrs,
 		producers:        producers,
 		mode:             selectedMode,
-		hostPool:         hostPool,
+		hostPool:         newHostPool(destNsqdTCPAddrs),
+		cfg:              pCfg,
+		logger:           nsqLogger,
 		respChan:         make(chan *nsq.ProducerTransaction, len(destNsqdTCPAddrs)),
 		perAddressStatus: perAddressStatus,
 		timermetrics:     timer_metrics.NewTimerMetrics(*statusEvery, "[aggregate]:"),
@@ -368,6 +519,9 @@ func main() {
 		if err != nil {
 			log.Fatal(err)
 		}
+		if nsqLogger != nil {
+			consumer.SetLogger(nsqLogger, nsq.LogLevelInfo)
+		}
 
 		publishTopic := topic
 		if *destTopic != "" {
@@ -382,6 +536,9 @@ func main() {
 	for i := 0; i < len(destNsqdTCPAddrs); i++ {
 		go publisher.responder()
 	}
+	if len(destLookupdHTTPAddrs) > 0 {
+		go publisher.refreshDestinations(publishTopics, destLookupdHTTPAddrs, *destLookupdPollInterval)
+	}
 
 	for _, consumer := range consumerList {
 		err := consumer.ConnectToNSQDs(nsqdTCPAddrs)
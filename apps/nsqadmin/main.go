@@ -26,6 +26,7 @@ func nsqadminFlagSet(opts *nsqadmin.Options) *flag.FlagSet {
 	logLevel := opts.LogLevel
 	flagSet.Var(&logLevel, "log-level", "set log verbosity: debug, info, warn, error, or fatal")
 	flagSet.String("log-prefix", "[nsqadmin] ", "log message prefix")
+	flagSet.String("log-format", opts.LogFormat, "log output format: text or json")
 	flagSet.Bool("verbose", false, "[deprecated] has no effect, use --log-level")
 
 	flagSet.String("http-address", opts.HTTPAddress, "<addr>:<port> to listen on for HTTP clients")
@@ -41,6 +42,18 @@ func nsqadminFlagSet(opts *nsqadmin.Options) *flag.FlagSet {
 	flagSet.Duration("statsd-interval", opts.StatsdInterval, "time interval nsqd is configured to push to statsd (must match nsqd)")
 
 	flagSet.String("notification-http-endpoint", "", "HTTP endpoint (fully qualified) to which POST notifications of admin actions will be sent")
+	flagSet.Int("notification-max-attempts", opts.NotificationMaxAttempts, "number of times a notification of an admin action is POSTed before giving up")
+	flagSet.String("audit-log-file", opts.AuditLogFile, "path to a file every admin action is appended to (if empty only the most recent actions are kept in memory)")
+	flagSet.Int64("audit-log-max-size", opts.AuditLogMaxSize, "size in bytes at which --audit-log-file is rotated")
+	flagSet.Int("audit-log-max-backups", opts.AuditLogMaxBackups, "number of rotated audit log files to keep")
+
+	flagSet.String("alert-rules-file", opts.AlertRulesFile, "path to a JSON file of alert rules (rules changed through the API are written back to it)")
+	flagSet.Duration("alert-interval", opts.AlertInterval, "duration of time between evaluations of the alert rules")
+	alertWebhookURLs := app.StringArray{}
+	flagSet.Var(&alertWebhookURLs, "alert-webhook-url", "URL to POST alerts to when they fire or resolve (may be given multiple times)")
+
+	flagSet.Duration("history-interval", opts.HistoryInterval, "duration of time between samples of the cluster stats kept for graphs when --graphite-url is not set (0 to disable)")
+	flagSet.Int("history-size", opts.HistorySize, "number of samples of the cluster stats kept")
 
 	flagSet.Duration("http-client-connect-timeout", opts.HTTPClientConnectTimeout, "timeout for HTTP connect")
 	flagSet.Duration("http-client-request-timeout", opts.HTTPClientRequestTimeout, "timeout for HTTP request")
@@ -49,16 +62,33 @@ func nsqadminFlagSet(opts *nsqadmin.Options) *flag.FlagSet {
 	flagSet.String("http-client-tls-root-ca-file", "", "path to CA file for the HTTP client")
 	flagSet.String("http-client-tls-cert", "", "path to certificate file for the HTTP client")
 	flagSet.String("http-client-tls-key", "", "path to key file for the HTTP client")
+	flagSet.String("http-client-auth-secret", "", "secret presented to nsqd and nsqlookupd that require auth")
 
 	flagSet.String("allow-config-from-cidr", opts.AllowConfigFromCIDR, "A CIDR from which to allow HTTP requests to the /config endpoint")
 	flagSet.String("acl-http-header", opts.AclHttpHeader, "HTTP header to check for authenticated admin users")
+	flagSet.String("acl-groups-http-header", opts.AclGroupsHttpHeader, "HTTP header to check for the comma separated groups of authenticated users (groups are not read from requests when empty)")
+	flagSet.String("default-role", opts.DefaultRole, "role of users without a role binding when --admin-user or --role-binding is given (viewer, operator or admin)")
 
 	nsqlookupdHTTPAddresses := app.StringArray{}
 	flagSet.Var(&nsqlookupdHTTPAddresses, "lookupd-http-address", "lookupd HTTP address (may be given multiple times)")
 	nsqdHTTPAddresses := app.StringArray{}
 	flagSet.Var(&nsqdHTTPAddresses, "nsqd-http-address", "nsqd HTTP address (may be given multiple times)")
+	clusters := app.StringArray{}
+	flagSet.Var(&clusters, "cluster", "additional cluster as NAME:KEY=VALUE[,KEY=VALUE...] with keys lookupd-http-address, nsqd-http-address (may be repeated), http-client-tls-* and http-client-auth-secret, served under /clusters/NAME (may be given multiple times)")
 	adminUsers := app.StringArray{}
 	flagSet.Var(&adminUsers, "admin-user", "admin user (may be given multiple times; if specified, only these users will be able to perform privileged actions; acl-http-header is used to determine the authenticated user)")
+	roleBindings := app.StringArray{}
+	flagSet.Var(&roleBindings, "role-binding", "grant a role as ROLE:user:NAME[:TOPIC_PATTERN] or ROLE:group:NAME[:TOPIC_PATTERN] (may be given multiple times; operators may pause, unpause and empty, admins may also create, delete and tombstone)")
+
+	flagSet.String("oidc-issuer-url", "", "OpenID Connect issuer to log users in with (enables login, the user and groups of requests then come from the issuer rather than acl-http-header)")
+	flagSet.String("oidc-client-id", "", "OpenID Connect client ID")
+	flagSet.String("oidc-client-secret", "", "OpenID Connect client secret")
+	flagSet.String("oidc-redirect-url", "", "URL of the /oidc/callback endpoint of this nsqadmin, as registered with the issuer")
+	flagSet.String("oidc-scopes", opts.OIDCScopes, "space separated scopes requested from the issuer")
+	flagSet.String("oidc-username-claim", opts.OIDCUsernameClaim, "ID token claim used as the user name (falls back to sub)")
+	flagSet.String("oidc-groups-claim", opts.OIDCGroupsClaim, "ID token claim listing the groups of the user, matched by role bindings")
+	flagSet.String("oidc-cookie-secret", "", "secret signing session cookies (random when not set, sessions then don't survive a restart)")
+	flagSet.Duration("oidc-session-duration", opts.OIDCSessionDuration, "duration of time a login lasts")
 
 	return flagSet
 }
@@ -115,6 +115,7 @@ func nsqdFlagSet(opts *nsqd.Options) *flag.FlagSet {
 	logLevel := opts.LogLevel
 	flagSet.Var(&logLevel, "log-level", "set log verbosity: debug, info, warn, error, or fatal")
 	flagSet.String("log-prefix", "[nsqd] ", "log message prefix")
+	flagSet.String("log-format", opts.LogFormat, "log output format: text or json")
 	flagSet.Bool("verbose", false, "[deprecated] has no effect, use --log-level")
 
 	flagSet.Int64("node-id", opts.ID, "unique part for message IDs, (int) in range [0,1024) (default is hash of hostname)")
@@ -125,11 +126,24 @@ func nsqdFlagSet(opts *nsqd.Options) *flag.FlagSet {
 	flagSet.String("tcp-address", opts.TCPAddress, "<addr>:<port> to listen on for TCP clients")
 	authHTTPAddresses := app.StringArray{}
 	flagSet.Var(&authHTTPAddresses, "auth-http-address", "<addr>:<port> or a full url to query auth server (may be given multiple times)")
+	flagSet.String("auth-policy-file", opts.AuthPolicyFile, "path to a JSON auth policy file (consulted before --auth-http-address, reloaded on change)")
+	flagSet.Duration("auth-policy-refresh", opts.AuthPolicyRefresh, "duration between checks of --auth-policy-file for changes")
 	flagSet.String("broadcast-address", opts.BroadcastAddress, "address that will be registered with lookupd (defaults to the OS hostname)")
 	flagSet.Int("broadcast-tcp-port", opts.BroadcastTCPPort, "TCP port that will be registered with lookupd (defaults to the TCP port that this nsqd is listening on)")
 	flagSet.Int("broadcast-http-port", opts.BroadcastHTTPPort, "HTTP port that will be registered with lookupd (defaults to the HTTP port that this nsqd is listening on)")
+	flagSet.String("zone", opts.Zone, "zone that will be registered with lookupd for topology-aware lookups")
+	flagSet.String("region", opts.Region, "region that will be registered with lookupd for topology-aware lookups")
+	flagSet.String("rack", opts.Rack, "rack that will be registered with lookupd for topology-aware lookups")
+	tags := app.StringArray{}
+	flagSet.Var(&tags, "tag", "tag that will be registered with lookupd for topology-aware lookups (may be given multiple times)")
 	lookupdTCPAddrs := app.StringArray{}
 	flagSet.Var(&lookupdTCPAddrs, "lookupd-tcp-address", "lookupd TCP address (may be given multiple times)")
+	flagSet.String("otlp-endpoint", opts.OTLPEndpoint, "<addr>:<port> or full URL of an OTLP/HTTP collector to export spans for traced messages to")
+	flagSet.Bool("trace-message-header", opts.TraceMessageHeader, "carry trace context to consumers in the message body, prefixed as \"\\x00traceparent:<traceparent>\\n\" (from the traceparent header of HTTP publishes, pointed at the nsqd publish span when exporting)")
+	flagSet.String("audit-log-path", opts.AuditLogPath, "path to a file to append JSON audit records of administrative actions to")
+	flagSet.Int64("audit-log-max-size", opts.AuditLogMaxSize, "size in bytes at which --audit-log-path is rotated")
+	flagSet.Int("audit-log-max-backups", opts.AuditLogMaxBackups, "number of rotated audit log files to keep")
+	flagSet.Bool("audit-topic", opts.AuditTopic, "also publish audit records to the __audit topic")
 	flagSet.Duration("http-client-connect-timeout", opts.HTTPClientConnectTimeout, "timeout for HTTP connect")
 	flagSet.Duration("http-client-request-timeout", opts.HTTPClientRequestTimeout, "timeout for HTTP request")
 
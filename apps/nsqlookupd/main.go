@@ -11,6 +11,7 @@ import (
 	"github.com/BurntSushi/toml"
 	"github.com/judwhite/go-svc"
 	"github.com/mreiferson/go-options"
+	"github.com/nsqio/nsq/internal/app"
 	"github.com/nsqio/nsq/internal/lg"
 	"github.com/nsqio/nsq/internal/version"
 	"github.com/nsqio/nsq/nsqlookupd"
@@ -25,6 +26,7 @@ func nsqlookupdFlagSet(opts *nsqlookupd.Options) *flag.FlagSet {
 	logLevel := opts.LogLevel
 	flagSet.Var(&logLevel, "log-level", "set log verbosity: debug, info, warn, error, or fatal")
 	flagSet.String("log-prefix", "[nsqlookupd] ", "log message prefix")
+	flagSet.String("log-format", opts.LogFormat, "log output format: text or json")
 	flagSet.Bool("verbose", false, "[deprecated] has no effect, use --log-level")
 
 	flagSet.String("tcp-address", opts.TCPAddress, "<addr>:<port> to listen on for TCP clients")
@@ -33,6 +35,32 @@ func nsqlookupdFlagSet(opts *nsqlookupd.Options) *flag.FlagSet {
 
 	flagSet.Duration("inactive-producer-timeout", opts.InactiveProducerTimeout, "duration of time a producer will remain in the active list since its last ping")
 	flagSet.Duration("tombstone-lifetime", opts.TombstoneLifetime, "duration of time a producer will remain tombstoned if registration remains")
+	flagSet.Duration("metadata-tombstone-lifetime", opts.MetadataTombstoneLifetime, "duration of time the metadata of a deleted topic or channel is remembered as deleted, so that stale copies on other nsqlookupd are not resurrected")
+
+	flagSet.String("data-path", opts.DataPath, "path to persist registrations and tombstones across restarts (disabled when empty)")
+	flagSet.Duration("snapshot-interval", opts.SnapshotInterval, "duration of time between compacting the registration journal into a snapshot")
+
+	peerHTTPAddrs := app.StringArray{}
+	flagSet.Var(&peerHTTPAddrs, "peer-http-address", "HTTP address of a peer nsqlookupd to exchange registrations with (may be given multiple times)")
+	flagSet.String("peer-auth-secret", opts.PeerAuthSecret, "secret presented to peer nsqlookupd that require auth")
+	flagSet.Duration("gossip-interval", opts.GossipInterval, "duration of time between syncing registrations with peers")
+
+	authHTTPAddresses := app.StringArray{}
+	flagSet.Var(&authHTTPAddresses, "auth-http-address", "<addr>:<port> or a full url to query auth server (may be given multiple times)")
+	flagSet.String("auth-policy-file", opts.AuthPolicyFile, "path to a JSON auth policy file (consulted before --auth-http-address, reloaded on change)")
+	flagSet.Duration("auth-policy-refresh", opts.AuthPolicyRefresh, "duration between checks of --auth-policy-file for changes")
+	flagSet.Bool("auth-read-required", opts.AuthReadRequired, "require credentials for read-only endpoints as well as admin ones when auth is enabled")
+
+	eventWebhookURLs := app.StringArray{}
+	flagSet.Var(&eventWebhookURLs, "event-webhook-url", "URL to POST registration change events to (may be given multiple times)")
+	flagSet.String("event-nsqd-http-address", opts.EventNSQDHTTPAddress, "<addr>:<port> of an nsqd to publish registration change events to")
+	flagSet.String("event-topic", opts.EventTopic, "topic to publish registration change events to on --event-nsqd-http-address")
+	flagSet.Int("event-queue-size", opts.EventQueueSize, "number of undelivered registration change events to hold per destination")
+	flagSet.Int("event-max-attempts", opts.EventMaxAttempts, "number of attempts to deliver a registration change event before dropping it")
+	flagSet.Duration("event-retry-backoff", opts.EventRetryBackoff, "duration to wait before retrying a failed registration change event (doubles on each attempt)")
+
+	flagSet.Duration("http-client-connect-timeout", opts.HTTPClientConnectTimeout, "timeout for HTTP connect")
+	flagSet.Duration("http-client-request-timeout", opts.HTTPClientRequestTimeout, "timeout for HTTP request")
 
 	return flagSet
 }
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/nsqio/go-nsq"
+	"github.com/nsqio/nsq/internal/clusterinfo"
+	"github.com/nsqio/nsq/internal/lg"
+)
+
+// publisherPool publishes to the best nsqd for a topic as ranked by
+// nsqlookupd, failing over to the next best on error, and refreshes that
+// ranking every interval.
+type publisherPool struct {
+	ci               *clusterinfo.ClusterInfo
+	lookupdHTTPAddrs []string
+	topic            string
+	interval         time.Duration
+	cfg              *nsq.Config
+	logger           lg.Logger
+
+	addrs     []string
+	producers map[string]*nsq.Producer
+	refreshed time.Time
+}
+
+func newPublisherPool(ci *clusterinfo.ClusterInfo, lookupdHTTPAddrs []string, topic string,
+	interval time.Duration, cfg *nsq.Config, logger lg.Logger) *publisherPool {
+	return &publisherPool{
+		ci:               ci,
+		lookupdHTTPAddrs: lookupdHTTPAddrs,
+		topic:            topic,
+		interval:         interval,
+		cfg:              cfg,
+		logger:           logger,
+		producers:        make(map[string]*nsq.Producer),
+	}
+}
+
+// refresh queries nsqlookupd for the current ranking, the previous one is
+// kept if none could be queried
+func (p *publisherPool) refresh() error {
+	p.refreshed = time.Now()
+
+	publishers, err := p.ci.GetLookupdPublishers(p.topic, p.lookupdHTTPAddrs)
+	if err != nil {
+		log.Printf("WARNING: failed to query publishers - %s", err)
+		if len(publishers) == 0 {
+			return err
+		}
+	}
+
+	addrs := make([]string, 0, len(publishers))
+	current := make(map[string]bool)
+	for _, producer := range publishers {
+		addr := producer.TCPAddress()
+		if _, ok := p.producers[addr]; !ok {
+			producer, err := nsq.NewProducer(addr, p.cfg)
+			if err != nil {
+				return err
+			}
+			if p.logger != nil {
+				producer.SetLogger(p.logger, nsq.LogLevelInfo)
+			}
+			p.producers[addr] = producer
+		}
+		addrs = append(addrs, addr)
+		current[addr] = true
+	}
+	for addr, producer := range p.producers {
+		if !current[addr] {
+			producer.Stop()
+			delete(p.producers, addr)
+		}
+	}
+	p.addrs = addrs
+	return nil
+}
+
+func (p *publisherPool) Publish(body []byte) error {
+	if len(p.addrs) == 0 || time.Since(p.refreshed) >= p.interval {
+		err := p.refresh()
+		if err != nil && len(p.addrs) == 0 {
+			return err
+		}
+	}
+	if len(p.addrs) == 0 {
+		return errors.New("no nsqd available to publish to")
+	}
+
+	var err error
+	for _, addr := range p.addrs {
+		err = p.producers[addr].Publish(p.topic, body)
+		if err == nil {
+			return nil
+		}
+		log.Printf("WARNING: failed to publish to %s - %s", addr, err)
+	}
+	// refresh on the next publish, the ranking is likely out of date
+	p.refreshed = time.Time{}
+	return err
+}
+
+func (p *publisherPool) Stop() {
+	for _, producer := range p.producers {
+		producer.Stop()
+	}
+}
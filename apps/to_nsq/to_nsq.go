@@ -17,18 +17,27 @@ import (
 
 	"github.com/nsqio/go-nsq"
 	"github.com/nsqio/nsq/internal/app"
+	"github.com/nsqio/nsq/internal/clusterinfo"
+	"github.com/nsqio/nsq/internal/http_api"
 	"github.com/nsqio/nsq/internal/version"
 )
 
 var (
 	topic     = flag.String("topic", "", "NSQ topic to publish to")
 	delimiter = flag.String("delimiter", "\n", "character to split input from stdin")
+	logFormat = flag.String("log-format", "text", "log output format: text or json")
+
+	lookupdPollInterval = flag.Duration("lookupd-poll-interval", time.Minute, "how often to refresh the nsqd to publish to from nsqlookupd")
+	httpConnectTimeout  = flag.Duration("http-client-connect-timeout", 2*time.Second, "timeout for HTTP connect")
+	httpRequestTimeout  = flag.Duration("http-client-request-timeout", 5*time.Second, "timeout for HTTP request")
 
 	destNsqdTCPAddrs = app.StringArray{}
+	lookupdHTTPAddrs = app.StringArray{}
 )
 
 func init() {
 	flag.Var(&destNsqdTCPAddrs, "nsqd-tcp-address", "destination nsqd TCP address (may be given multiple times)")
+	flag.Var(&lookupdHTTPAddrs, "lookupd-http-address", "lookupd HTTP address to discover the nsqd to publish to (may be given multiple times)")
 }
 
 func main() {
@@ -38,6 +47,11 @@ func main() {
 
 	flag.Parse()
 
+	nsqLogger, err := app.SetLogFormat(*logFormat, "to_nsq")
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	if len(*topic) == 0 {
 		log.Fatal("--topic required")
 	}
@@ -52,18 +66,52 @@ func main() {
 
 	cfg.UserAgent = fmt.Sprintf("to_nsq/%s go-nsq/%s", version.Binary, nsq.VERSION)
 
-	// make the producers
-	producers := make(map[string]*nsq.Producer)
-	for _, addr := range destNsqdTCPAddrs {
-		producer, err := nsq.NewProducer(addr, cfg)
-		if err != nil {
-			log.Fatalf("failed to create nsq.Producer - %s", err)
-		}
-		producers[addr] = producer
+	if len(destNsqdTCPAddrs) == 0 && len(lookupdHTTPAddrs) == 0 {
+		log.Fatal("--nsqd-tcp-address or --lookupd-http-address required")
+	}
+	if len(destNsqdTCPAddrs) > 0 && len(lookupdHTTPAddrs) > 0 {
+		log.Fatal("use --nsqd-tcp-address or --lookupd-http-address not both")
 	}
 
-	if len(producers) == 0 {
-		log.Fatal("--nsqd-tcp-address required")
+	var publish func([]byte) error
+	var stop func()
+	if len(lookupdHTTPAddrs) > 0 {
+		// publish to the best nsqd according to nsqlookupd
+		ci := clusterinfo.New(nil, http_api.NewClient(nil, *httpConnectTimeout, *httpRequestTimeout))
+		pool := newPublisherPool(ci, lookupdHTTPAddrs, *topic, *lookupdPollInterval, cfg, nsqLogger)
+		err := pool.refresh()
+		if err != nil {
+			log.Fatalf("failed to discover nsqd from nsqlookupd - %s", err)
+		}
+		publish = pool.Publish
+		stop = pool.Stop
+	} else {
+		// make the producers
+		producers := make(map[string]*nsq.Producer)
+		for _, addr := range destNsqdTCPAddrs {
+			producer, err := nsq.NewProducer(addr, cfg)
+			if err != nil {
+				log.Fatalf("failed to create nsq.Producer - %s", err)
+			}
+			if nsqLogger != nil {
+				producer.SetLogger(nsqLogger, nsq.LogLevelInfo)
+			}
+			producers[addr] = producer
+		}
+		publish = func(body []byte) error {
+			for _, producer := range producers {
+				err := producer.Publish(*topic, body)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		stop = func() {
+			for _, producer := range producers {
+				producer.Stop()
+			}
+		}
 	}
 
 	throttleEnabled := *rate >= 1
@@ -98,10 +146,10 @@ func main() {
 				if currentBalance <= 0 {
 					time.Sleep(interval)
 				}
-				err = readAndPublish(r, delim, producers)
+				err = readAndPublish(r, delim, publish)
 				atomic.AddInt64(&balance, -1)
 			} else {
-				err = readAndPublish(r, delim, producers)
+				err = readAndPublish(r, delim, publish)
 			}
 			if err != nil {
 				if err != io.EOF {
@@ -118,14 +166,12 @@ func main() {
 	case <-stopChan:
 	}
 
-	for _, producer := range producers {
-		producer.Stop()
-	}
+	stop()
 }
 
 // readAndPublish reads to the delim from r and publishes the bytes
-// to the map of producers.
-func readAndPublish(r *bufio.Reader, delim byte, producers map[string]*nsq.Producer) error {
+// with publish.
+func readAndPublish(r *bufio.Reader, delim byte, publish func([]byte) error) error {
 	line, readErr := r.ReadBytes(delim)
 
 	if len(line) > 0 {
@@ -137,11 +183,9 @@ func readAndPublish(r *bufio.Reader, delim byte, producers map[string]*nsq.Produ
 		return readErr
 	}
 
-	for _, producer := range producers {
-		err := producer.Publish(*topic, line)
-		if err != nil {
-			return err
-		}
+	err := publish(line)
+	if err != nil {
+		return err
 	}
 
 	return readErr
@@ -0,0 +1,25 @@
+package app
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/nsqio/nsq/internal/lg"
+)
+
+// SetLogFormat switches the standard library logger to format and returns
+// the Logger to hand to go-nsq's SetLogger (nil for the default text format)
+func SetLogFormat(format string, component string) (lg.Logger, error) {
+	switch format {
+	case lg.TextFormat, "":
+		return nil, nil
+	case lg.JSONFormat:
+		logger := lg.NewJSONLogger(os.Stderr, component)
+		log.SetFlags(0)
+		log.SetPrefix("")
+		log.SetOutput(logger)
+		return logger, nil
+	}
+	return nil, fmt.Errorf("invalid log format '%s' (text, json)", format)
+}
@@ -38,13 +38,16 @@ func (a *Authorization) HasPermission(permission string) bool {
 
 func (a *Authorization) IsAllowed(topic, channel string) bool {
 	if channel != "" {
-		if !a.HasPermission("subscribe") {
-			return false
-		}
-	} else {
-		if !a.HasPermission("publish") {
-			return false
-		}
+		return a.IsPermitted("subscribe", topic, channel)
+	}
+	return a.IsPermitted("publish", topic, channel)
+}
+
+// IsPermitted returns true if this authorization grants permission on
+// the given topic and channel (channel may be empty for topic level actions)
+func (a *Authorization) IsPermitted(permission, topic, channel string) bool {
+	if !a.HasPermission(permission) {
+		return false
 	}
 
 	topicRegex := regexp.MustCompile(a.Topic)
@@ -71,6 +74,15 @@ func (a *State) IsAllowed(topic, channel string) bool {
 	return false
 }
 
+func (a *State) IsPermitted(permission, topic, channel string) bool {
+	for _, aa := range a.Authorizations {
+		if aa.IsPermitted(permission, topic, channel) {
+			return true
+		}
+	}
+	return false
+}
+
 func (a *State) IsExpired() bool {
 	if a.Expires.Before(time.Now()) {
 		return true
@@ -120,30 +132,37 @@ func QueryAuthd(authd string, remoteIP string, tlsEnabled bool, commonName strin
 	}
 
 	// validation on response
-	for _, auth := range authState.Authorizations {
+	if err := validateAuthorizations(authState.Authorizations); err != nil {
+		return nil, err
+	}
+
+	if authState.TTL <= 0 {
+		return nil, fmt.Errorf("invalid TTL %d (must be >0)", authState.TTL)
+	}
+
+	authState.Expires = time.Now().Add(time.Duration(authState.TTL) * time.Second)
+	return &authState, nil
+}
+
+func validateAuthorizations(authorizations []Authorization) error {
+	for _, auth := range authorizations {
 		for _, p := range auth.Permissions {
 			switch p {
-			case "subscribe", "publish":
+			case "subscribe", "publish", "admin":
 			default:
-				return nil, fmt.Errorf("unknown permission %s", p)
+				return fmt.Errorf("unknown permission %s", p)
 			}
 		}
 
 		if _, err := regexp.Compile(auth.Topic); err != nil {
-			return nil, fmt.Errorf("unable to compile topic %q %s", auth.Topic, err)
+			return fmt.Errorf("unable to compile topic %q %s", auth.Topic, err)
 		}
 
 		for _, channel := range auth.Channels {
 			if _, err := regexp.Compile(channel); err != nil {
-				return nil, fmt.Errorf("unable to compile channel %q %s", channel, err)
+				return fmt.Errorf("unable to compile channel %q %s", channel, err)
 			}
 		}
 	}
-
-	if authState.TTL <= 0 {
-		return nil, fmt.Errorf("invalid TTL %d (must be >0)", authState.TTL)
-	}
-
-	authState.Expires = time.Now().Add(time.Duration(authState.TTL) * time.Second)
-	return &authState, nil
+	return nil
 }
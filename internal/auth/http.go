@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNoCredentials is returned for requests that present neither a secret
+// nor a TLS client certificate
+var ErrNoCredentials = errors.New("no credentials")
+
+// QueryFunc resolves credentials to a State
+type QueryFunc func(remoteIP string, tlsEnabled bool, commonName string, authSecret string) (*State, error)
+
+type httpCredentials struct {
+	remoteIP   string
+	tlsEnabled bool
+	commonName string
+	secret     string
+}
+
+// HTTPAuth resolves the credentials presented with HTTP requests and holds
+// the resulting State until it expires so that every request does not result
+// in a round trip to authd
+type HTTPAuth struct {
+	sync.Mutex
+	query  QueryFunc
+	states map[httpCredentials]*State
+}
+
+func NewHTTPAuth(query QueryFunc) *HTTPAuth {
+	return &HTTPAuth{
+		query:  query,
+		states: make(map[httpCredentials]*State),
+	}
+}
+
+// Authenticate returns the State for the "Authorization: Bearer <secret>"
+// header and/or the TLS client certificate of req
+func (a *HTTPAuth) Authenticate(req *http.Request) (*State, error) {
+	k := httpCredentials{secret: secretFromRequest(req)}
+	k.remoteIP, _, _ = net.SplitHostPort(req.RemoteAddr)
+	if req.TLS != nil {
+		k.tlsEnabled = true
+		if len(req.TLS.PeerCertificates) > 0 {
+			k.commonName = req.TLS.PeerCertificates[0].Subject.CommonName
+		}
+	}
+	if k.secret == "" && k.commonName == "" {
+		return nil, ErrNoCredentials
+	}
+
+	state := a.get(k)
+	if state != nil {
+		return state, nil
+	}
+	state, err := a.query(k.remoteIP, k.tlsEnabled, k.commonName, k.secret)
+	if err != nil {
+		return nil, err
+	}
+	a.set(k, state)
+	return state, nil
+}
+
+// Clear drops every cached State, the credentials are resolved again on
+// their next use (e.g. against a reloaded auth policy)
+func (a *HTTPAuth) Clear() {
+	a.Lock()
+	defer a.Unlock()
+	a.states = make(map[httpCredentials]*State)
+}
+
+func (a *HTTPAuth) get(k httpCredentials) *State {
+	a.Lock()
+	defer a.Unlock()
+	state, ok := a.states[k]
+	if !ok || state.IsExpired() {
+		return nil
+	}
+	return state
+}
+
+func (a *HTTPAuth) set(k httpCredentials, state *State) {
+	a.Lock()
+	defer a.Unlock()
+	now := time.Now()
+	for key, s := range a.states {
+		if s.Expires.Before(now) {
+			delete(a.states, key)
+		}
+	}
+	a.states[k] = state
+}
+
+// secretFromRequest returns the secret from an "Authorization: Bearer <secret>" header
+func secretFromRequest(req *http.Request) string {
+	const prefix = "bearer "
+	h := req.Header.Get("Authorization")
+	if len(h) < len(prefix) || !strings.EqualFold(h[:len(prefix)], prefix) {
+		return ""
+	}
+	return strings.TrimSpace(h[len(prefix):])
+}
@@ -0,0 +1,122 @@
+package auth
+
+import (
+	"errors"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/nsqio/nsq/internal/lg"
+)
+
+type policyState struct {
+	policy  *StaticPolicy
+	modTime time.Time
+	size    int64
+}
+
+// PolicyWatcher holds the StaticPolicy loaded from a file and swaps in a new
+// one when the file is modified
+type PolicyWatcher struct {
+	fn     string
+	logf   lg.AppLogFunc
+	onLoad func()
+	state  atomic.Value
+}
+
+// NewPolicyWatcher loads the policy file at fn. onLoad, when not nil, is
+// called every time a policy has been (re)loaded.
+func NewPolicyWatcher(fn string, logf lg.AppLogFunc, onLoad func()) (*PolicyWatcher, error) {
+	w := &PolicyWatcher{
+		fn:     fn,
+		logf:   logf,
+		onLoad: onLoad,
+	}
+	w.state.Store(policyState{})
+	err := w.load()
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *PolicyWatcher) load() error {
+	fi, err := os.Stat(w.fn)
+	if err != nil {
+		return err
+	}
+
+	policy, err := LoadStaticPolicy(w.fn)
+	if err != nil {
+		return err
+	}
+
+	w.state.Store(policyState{
+		policy:  policy,
+		modTime: fi.ModTime(),
+		size:    fi.Size(),
+	})
+	if w.onLoad != nil {
+		w.onLoad()
+	}
+	w.logf(lg.INFO, "AUTH: loaded %d identities from %s", len(policy.Identities), w.fn)
+	return nil
+}
+
+// Policy returns the current policy, nil for a nil PolicyWatcher
+func (w *PolicyWatcher) Policy() *StaticPolicy {
+	if w == nil {
+		return nil
+	}
+	return w.state.Load().(policyState).policy
+}
+
+// Run checks the policy file for modifications every interval until
+// exitChan is closed. A policy that fails to load is logged and the
+// previous one stays in effect.
+func (w *PolicyWatcher) Run(interval time.Duration, exitChan chan int) {
+	ticker := time.NewTicker(interval)
+	for {
+		select {
+		case <-ticker.C:
+			fi, err := os.Stat(w.fn)
+			if err != nil {
+				w.logf(lg.ERROR, "AUTH: failed to stat policy file - %s", err)
+				continue
+			}
+			cur := w.state.Load().(policyState)
+			if fi.ModTime().Equal(cur.modTime) && fi.Size() == cur.size {
+				continue
+			}
+			err = w.load()
+			if err != nil {
+				w.logf(lg.ERROR, "AUTH: failed to reload policy file - %s", err)
+			}
+		case <-exitChan:
+			goto exit
+		}
+	}
+
+exit:
+	w.logf(lg.INFO, "AUTH: closing")
+	ticker.Stop()
+}
+
+// Query resolves credentials to a State. The policy, when not nil, takes
+// precedence, the auth servers are only consulted for clients it does not
+// know about.
+func Query(policy *StaticPolicy, authd []string, remoteIP string, tlsEnabled bool, commonName string, authSecret string,
+	connectTimeout time.Duration, requestTimeout time.Duration) (*State, error) {
+	if policy != nil {
+		if authState := policy.Query(tlsEnabled, commonName, authSecret); authState != nil {
+			return authState, nil
+		}
+	}
+
+	if len(authd) == 0 {
+		return nil, errors.New("no matching identity in auth policy")
+	}
+
+	return QueryAnyAuthd(authd, remoteIP, tlsEnabled, commonName, authSecret,
+		connectTimeout, requestTimeout)
+}
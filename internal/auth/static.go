@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+)
+
+const defaultStaticTTL = 60
+
+// StaticIdentity maps a secret and/or a TLS client certificate common name
+// to a set of authorizations
+type StaticIdentity struct {
+	Secret         string          `json:"secret"`
+	CommonName     string          `json:"common_name"`
+	Identity       string          `json:"identity"`
+	IdentityURL    string          `json:"identity_url"`
+	Authorizations []Authorization `json:"authorizations"`
+}
+
+// StaticPolicy is a locally defined auth policy, loaded from a JSON file,
+// that answers the same questions an auth server would
+type StaticPolicy struct {
+	TTL        int              `json:"ttl"`
+	Identities []StaticIdentity `json:"identities"`
+}
+
+// LoadStaticPolicy reads and validates the policy file at fn
+func LoadStaticPolicy(fn string) (*StaticPolicy, error) {
+	data, err := ioutil.ReadFile(fn)
+	if err != nil {
+		return nil, err
+	}
+
+	var p StaticPolicy
+	err = json.Unmarshal(data, &p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse auth policy %s - %s", fn, err)
+	}
+
+	if p.TTL < 0 {
+		return nil, fmt.Errorf("invalid TTL %d (must be >=0)", p.TTL)
+	}
+	if p.TTL == 0 {
+		p.TTL = defaultStaticTTL
+	}
+
+	for i, identity := range p.Identities {
+		if identity.Secret == "" && identity.CommonName == "" {
+			return nil, fmt.Errorf("identity %d has neither a secret nor a common_name", i)
+		}
+		if err := validateAuthorizations(identity.Authorizations); err != nil {
+			return nil, err
+		}
+	}
+
+	return &p, nil
+}
+
+// Query returns the State for the first identity matching the given secret
+// or, for TLS connections, the given certificate common name.
+//
+// nil is returned when nothing in the policy matches.
+func (p *StaticPolicy) Query(tlsEnabled bool, commonName string, authSecret string) *State {
+	for _, identity := range p.Identities {
+		if !identity.matches(tlsEnabled, commonName, authSecret) {
+			continue
+		}
+		return &State{
+			TTL:            p.TTL,
+			Authorizations: identity.Authorizations,
+			Identity:       identity.Identity,
+			IdentityURL:    identity.IdentityURL,
+			Expires:        time.Now().Add(time.Duration(p.TTL) * time.Second),
+		}
+	}
+	return nil
+}
+
+func (i *StaticIdentity) matches(tlsEnabled bool, commonName string, authSecret string) bool {
+	if i.Secret != "" &&
+		subtle.ConstantTimeCompare([]byte(i.Secret), []byte(authSecret)) != 1 {
+		return false
+	}
+	if i.CommonName != "" && (!tlsEnabled || i.CommonName != commonName) {
+		return false
+	}
+	return true
+}
@@ -1,13 +1,18 @@
 package clusterinfo
 
 import (
+	"bytes"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"math/rand"
 	"net"
 	"net/url"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/blang/semver"
 	"github.com/nsqio/nsq/internal/http_api"
@@ -15,6 +20,11 @@ import (
 	"github.com/nsqio/nsq/internal/stringy"
 )
 
+var (
+	ErrUnknownNode      = errors.New("unknown node")
+	ErrNoTopicProducers = errors.New("topic has no producers")
+)
+
 type PartialErr interface {
 	error
 	Errors() []error
@@ -46,6 +56,15 @@ func New(log lg.AppLogFunc, client *http_api.Client) *ClusterInfo {
 	}
 }
 
+// WithAuthSecret returns a copy of the ClusterInfo that presents secret to
+// the nsqd and nsqlookupd it queries and acts on
+func (c *ClusterInfo) WithAuthSecret(secret string) *ClusterInfo {
+	return &ClusterInfo{
+		log:    c.log,
+		client: c.client.WithAuthSecret(secret),
+	}
+}
+
 func (c *ClusterInfo) logf(f string, args ...interface{}) {
 	if c.log != nil {
 		c.log(lg.INFO, f, args...)
@@ -288,6 +307,221 @@ func (c *ClusterInfo) GetLookupdTopicProducers(topic string, lookupdHTTPAddrs []
 	return producers, nil
 }
 
+// GetLookupdPublishers returns the nsqd a client should publish topic to,
+// best first, as ranked by each nsqlookupd's /publishers
+//
+// Rankings are interleaved in the order of lookupdHTTPAddrs so that no
+// single nsqlookupd decides the whole order.
+func (c *ClusterInfo) GetLookupdPublishers(topic string, lookupdHTTPAddrs []string) (Producers, error) {
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	var errs []error
+
+	type respType struct {
+		Producers Producers `json:"producers"`
+	}
+
+	rankings := make([]Producers, len(lookupdHTTPAddrs))
+	for i, addr := range lookupdHTTPAddrs {
+		wg.Add(1)
+		go func(i int, addr string) {
+			defer wg.Done()
+
+			endpoint := fmt.Sprintf("http://%s/publishers?topic=%s", addr, url.QueryEscape(topic))
+			c.logf("CI: querying nsqlookupd %s", endpoint)
+
+			var resp respType
+			err := c.client.GETV1(endpoint, &resp)
+			if err != nil {
+				lock.Lock()
+				errs = append(errs, err)
+				lock.Unlock()
+				return
+			}
+			rankings[i] = resp.Producers
+		}(i, addr)
+	}
+	wg.Wait()
+
+	if len(errs) == len(lookupdHTTPAddrs) {
+		return nil, fmt.Errorf("Failed to query any nsqlookupd: %s", ErrList(errs))
+	}
+
+	var producers Producers
+	seen := make(map[string]bool)
+	for rank := 0; ; rank++ {
+		more := false
+		for _, ranking := range rankings {
+			if rank >= len(ranking) {
+				continue
+			}
+			more = true
+			p := ranking[rank]
+			if seen[p.TCPAddress()] {
+				continue
+			}
+			seen[p.TCPAddress()] = true
+			producers = append(producers, p)
+		}
+		if !more {
+			break
+		}
+	}
+
+	if len(errs) > 0 {
+		return producers, ErrList(errs)
+	}
+	return producers, nil
+}
+
+// GetLookupdMetadata returns the metadata of a topic or, when channel is not
+// empty, of one of its channels, as last updated on any of the given lookupd.
+// Deleted metadata is returned empty with the time of the delete, so a lookupd
+// that missed it does not bring it back.
+func (c *ClusterInfo) GetLookupdMetadata(topic string, channel string, lookupdHTTPAddrs []string) (map[string]string, error) {
+	var metadata map[string]string
+	var updatedAt int64
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	var errs []error
+
+	type respType struct {
+		Metadata  map[string]string `json:"metadata"`
+		UpdatedAt int64             `json:"updated_at"`
+	}
+
+	qs := fmt.Sprintf("topic=%s", url.QueryEscape(topic))
+	if channel != "" {
+		qs += fmt.Sprintf("&channel=%s", url.QueryEscape(channel))
+	}
+
+	for _, addr := range lookupdHTTPAddrs {
+		wg.Add(1)
+		go func(addr string) {
+			defer wg.Done()
+
+			endpoint := fmt.Sprintf("http://%s/metadata?%s", addr, qs)
+			c.logf("CI: querying nsqlookupd %s", endpoint)
+
+			var resp respType
+			err := c.client.GETV1(endpoint, &resp)
+			if err != nil {
+				lock.Lock()
+				errs = append(errs, err)
+				lock.Unlock()
+				return
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+			if metadata == nil || resp.UpdatedAt > updatedAt {
+				metadata = resp.Metadata
+				updatedAt = resp.UpdatedAt
+			}
+		}(addr)
+	}
+	wg.Wait()
+
+	if len(errs) == len(lookupdHTTPAddrs) {
+		return nil, fmt.Errorf("Failed to query any nsqlookupd: %s", ErrList(errs))
+	}
+	if len(errs) > 0 {
+		return metadata, ErrList(errs)
+	}
+	return metadata, nil
+}
+
+// SetLookupdMetadata replaces the metadata of a topic or, when channel is not
+// empty, of one of its channels on all the given lookupd, empty metadata
+// deletes it
+func (c *ClusterInfo) SetLookupdMetadata(topic string, channel string, metadata map[string]string, lookupdHTTPAddrs []string) error {
+	var errs []error
+
+	qs := fmt.Sprintf("topic=%s", url.QueryEscape(topic))
+	if channel != "" {
+		qs += fmt.Sprintf("&channel=%s", url.QueryEscape(channel))
+	}
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+
+	for _, addr := range lookupdHTTPAddrs {
+		endpoint := fmt.Sprintf("http://%s/metadata?%s", addr, qs)
+		c.logf("CI: querying nsqlookupd %s", endpoint)
+		err := c.client.POSTV1JSON(endpoint, metadata)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return ErrList(errs)
+	}
+	return nil
+}
+
+// GetLookupdTombstones returns the tombstones in effect for a given topic,
+// or for every topic when topic is empty, by unioning the tombstones of the
+// given lookupd
+func (c *ClusterInfo) GetLookupdTombstones(topic string, lookupdHTTPAddrs []string) ([]*Tombstone, error) {
+	var tombstones []*Tombstone
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	var errs []error
+
+	type respType struct {
+		Tombstones []*Tombstone `json:"tombstones"`
+	}
+
+	for _, addr := range lookupdHTTPAddrs {
+		wg.Add(1)
+		go func(addr string) {
+			defer wg.Done()
+
+			endpoint := fmt.Sprintf("http://%s/tombstones?topic=%s", addr, url.QueryEscape(topic))
+			c.logf("CI: querying nsqlookupd %s", endpoint)
+
+			var resp respType
+			err := c.client.GETV1(endpoint, &resp)
+			if err != nil {
+				lock.Lock()
+				errs = append(errs, err)
+				lock.Unlock()
+				return
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+			for _, t := range resp.Tombstones {
+				for _, tt := range tombstones {
+					if t.Topic == tt.Topic && t.Node == tt.Node {
+						if t.RemainingLifetime > tt.RemainingLifetime {
+							*tt = *t
+						}
+						goto skip
+					}
+				}
+				tombstones = append(tombstones, t)
+			skip:
+			}
+		}(addr)
+	}
+	wg.Wait()
+
+	if len(errs) == len(lookupdHTTPAddrs) {
+		return nil, fmt.Errorf("Failed to query any nsqlookupd: %s", ErrList(errs))
+	}
+	sort.Slice(tombstones, func(i, j int) bool {
+		if tombstones[i].Topic != tombstones[j].Topic {
+			return tombstones[i].Topic < tombstones[j].Topic
+		}
+		return tombstones[i].Node < tombstones[j].Node
+	})
+	if len(errs) > 0 {
+		return tombstones, ErrList(errs)
+	}
+	return tombstones, nil
+}
+
 // GetNSQDTopics returns a []string containing all the topics produced by the given nsqd
 func (c *ClusterInfo) GetNSQDTopics(nsqdHTTPAddrs []string) ([]string, error) {
 	var topics []string
@@ -669,6 +903,13 @@ func (c *ClusterInfo) TombstoneNodeForTopic(topic string, node string, lookupdHT
 	return nil
 }
 
+// UntombstoneNodeForTopic clears the tombstone of node for topic on all the
+// given lookupd, the topic is registered again once the node recreates it
+func (c *ClusterInfo) UntombstoneNodeForTopic(topic string, node string, lookupdHTTPAddrs []string) error {
+	qs := fmt.Sprintf("topic=%s&node=%s", url.QueryEscape(topic), url.QueryEscape(node))
+	return c.nsqlookupdPOST(lookupdHTTPAddrs, "topic/untombstone", qs)
+}
+
 func (c *ClusterInfo) CreateTopicChannel(topicName string, channelName string, lookupdHTTPAddrs []string) error {
 	var errs []error
 
@@ -832,6 +1073,100 @@ func (c *ClusterInfo) EmptyChannel(topicName string, channelName string, lookupd
 	return c.actionHelper(topicName, lookupdHTTPAddrs, nsqdHTTPAddrs, "channel/empty", qs)
 }
 
+// PublishMessages publishes messages to a topic on the nsqd whose HTTP
+// address is node or, with an empty node, on a random producer of the topic,
+// and returns the address of the nsqd it published to and the number of
+// messages published, which is less than len(messages) on error.
+//
+// Deferred messages are published one at a time, /mpub doesn't take a defer.
+func (c *ClusterInfo) PublishMessages(topicName string, node string, messages [][]byte, deferred time.Duration, lookupdHTTPAddrs []string, nsqdHTTPAddrs []string) (string, int, error) {
+	var producers Producers
+	var err error
+	if node != "" {
+		producers, err = c.GetProducers(lookupdHTTPAddrs, nsqdHTTPAddrs)
+	} else {
+		producers, err = c.GetTopicProducers(topicName, lookupdHTTPAddrs, nsqdHTTPAddrs)
+	}
+	if err != nil {
+		if _, ok := err.(PartialErr); !ok {
+			return "", 0, err
+		}
+		c.logf("CI: %s", err)
+	}
+
+	var producer *Producer
+	if node != "" {
+		producer = producers.Search(node)
+		if producer == nil {
+			return "", 0, ErrUnknownNode
+		}
+	} else {
+		if len(producers) == 0 {
+			return "", 0, ErrNoTopicProducers
+		}
+		producer = producers[rand.Intn(len(producers))]
+	}
+	addr := producer.HTTPAddress()
+
+	if len(messages) == 1 || deferred > 0 {
+		qs := fmt.Sprintf("topic=%s", url.QueryEscape(topicName))
+		if deferred > 0 {
+			qs += fmt.Sprintf("&defer=%d", deferred/time.Millisecond)
+		}
+		endpoint := fmt.Sprintf("http://%s/pub?%s", addr, qs)
+		for i, body := range messages {
+			c.logf("CI: querying nsqd %s", endpoint)
+			err := c.client.POSTV1Body(endpoint, body)
+			if err != nil {
+				return addr, i, err
+			}
+		}
+		return addr, len(messages), nil
+	}
+
+	// the binary format of /mpub is the message count then the size and body
+	// of each message
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.BigEndian, int32(len(messages)))
+	for _, body := range messages {
+		binary.Write(&buf, binary.BigEndian, int32(len(body)))
+		buf.Write(body)
+	}
+	endpoint := fmt.Sprintf("http://%s/mpub?topic=%s&binary=true", addr, url.QueryEscape(topicName))
+	c.logf("CI: querying nsqd %s", endpoint)
+	err = c.client.POSTV1Body(endpoint, buf.Bytes())
+	if err != nil {
+		return addr, 0, err
+	}
+	return addr, len(messages), nil
+}
+
+// DisconnectClient closes the connection of a client of a channel on the
+// nsqd whose HTTP address is node and, with requeue, immediately requeues the
+// messages it has in flight
+func (c *ClusterInfo) DisconnectClient(topicName string, channelName string, node string, clientID int64, requeue bool, lookupdHTTPAddrs []string, nsqdHTTPAddrs []string) error {
+	producers, err := c.GetTopicProducers(topicName, lookupdHTTPAddrs, nsqdHTTPAddrs)
+	if err != nil {
+		if _, ok := err.(PartialErr); !ok {
+			return err
+		}
+		c.logf("CI: %s", err)
+	}
+	producer := producers.Search(node)
+	if producer == nil {
+		return ErrUnknownNode
+	}
+
+	qs := fmt.Sprintf("topic=%s&channel=%s&id=%d",
+		url.QueryEscape(topicName), url.QueryEscape(channelName), clientID)
+	if requeue {
+		qs += "&requeue=true"
+	}
+	endpoint := fmt.Sprintf("http://%s/client/disconnect?%s", producer.HTTPAddress(), qs)
+	c.logf("CI: querying nsqd %s", endpoint)
+	return c.client.POSTV1(endpoint)
+}
+
 func (c *ClusterInfo) actionHelper(topicName string, lookupdHTTPAddrs []string, nsqdHTTPAddrs []string, uri string, qs string) error {
 	var errs []error
 
@@ -0,0 +1,363 @@
+package clusterinfo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/nsqio/nsq/internal/protocol"
+)
+
+const ephemeralSuffix = "#ephemeral"
+
+// spec change actions, in the order they are planned
+const (
+	SpecCreateTopic    = "create_topic"
+	SpecCreateChannel  = "create_channel"
+	SpecPauseTopic     = "pause_topic"
+	SpecUnPauseTopic   = "unpause_topic"
+	SpecPauseChannel   = "pause_channel"
+	SpecUnPauseChannel = "unpause_channel"
+	SpecDeleteChannel  = "delete_channel"
+)
+
+// Spec declares the topics and channels a cluster should have
+type Spec struct {
+	Topics []*TopicSpec `json:"topics"`
+}
+
+// TopicSpec declares a topic, whether it is paused and its channels.
+//
+// Ephemeral topics and channels are named with the #ephemeral suffix, they
+// only exist while they have clients so they are never created (nor pruned),
+// only paused or unpaused when they exist.
+type TopicSpec struct {
+	Name      string         `json:"name"`
+	Ephemeral bool           `json:"ephemeral"`
+	Paused    bool           `json:"paused"`
+	Channels  []*ChannelSpec `json:"channels"`
+}
+
+type ChannelSpec struct {
+	Name      string `json:"name"`
+	Ephemeral bool   `json:"ephemeral"`
+	Paused    bool   `json:"paused"`
+}
+
+func ephemeralName(name string, ephemeral bool) (string, bool) {
+	if strings.HasSuffix(name, ephemeralSuffix) {
+		return name, true
+	}
+	if ephemeral {
+		return name + ephemeralSuffix, true
+	}
+	return name, false
+}
+
+// ParseSpec parses and validates a spec given as JSON
+func ParseSpec(data []byte) (*Spec, error) {
+	var s Spec
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	err := dec.Decode(&s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid spec - %s", err)
+	}
+
+	topics := make(map[string]bool)
+	for _, t := range s.Topics {
+		t.Name, t.Ephemeral = ephemeralName(t.Name, t.Ephemeral)
+		if !protocol.IsValidTopicName(t.Name) {
+			return nil, fmt.Errorf("invalid topic name %q", t.Name)
+		}
+		if topics[t.Name] {
+			return nil, fmt.Errorf("duplicate topic %q", t.Name)
+		}
+		topics[t.Name] = true
+
+		channels := make(map[string]bool)
+		for _, ch := range t.Channels {
+			ch.Name, ch.Ephemeral = ephemeralName(ch.Name, ch.Ephemeral)
+			if !protocol.IsValidChannelName(ch.Name) {
+				return nil, fmt.Errorf("invalid channel name %q of topic %q", ch.Name, t.Name)
+			}
+			if channels[ch.Name] {
+				return nil, fmt.Errorf("duplicate channel %q of topic %q", ch.Name, t.Name)
+			}
+			channels[ch.Name] = true
+		}
+	}
+	return &s, nil
+}
+
+// specSkipNoNSQD is why a pause is skipped for a topic which no nsqd has,
+// nsqlookupd only hands it to an nsqd once it is published to
+const specSkipNoNSQD = "no nsqd has the topic yet, plan again once one does"
+
+// SpecChange is a change to a topic or channel planned from a Spec.
+//
+// Skipped is set, to the reason, for changes which can't be made yet, they
+// are only planned to be reported.
+type SpecChange struct {
+	Action  string `json:"action"`
+	Topic   string `json:"topic"`
+	Channel string `json:"channel,omitempty"`
+	Skipped string `json:"skipped,omitempty"`
+}
+
+func (c SpecChange) String() string {
+	s := fmt.Sprintf("%s %s", c.Action, c.Topic)
+	if c.Channel != "" {
+		s = fmt.Sprintf("%s %s/%s", c.Action, c.Topic, c.Channel)
+	}
+	if c.Skipped != "" {
+		s += fmt.Sprintf(" (skipped: %s)", c.Skipped)
+	}
+	return s
+}
+
+// SpecState is the live state of a cluster a Spec is planned against
+type SpecState struct {
+	Topics        []string            // every topic known to nsqlookupd (or the nsqd)
+	TopicChannels map[string][]string // the channels of each topic known to nsqlookupd, nil without nsqlookupd
+	TopicStats    []*TopicStats       // the stats of each topic on each nsqd
+}
+
+// GetSpecState returns the topics and channels of a cluster, with their
+// paused state on each nsqd
+func (c *ClusterInfo) GetSpecState(lookupdHTTPAddrs []string, nsqdHTTPAddrs []string) (*SpecState, error) {
+	var errs []error
+	var err error
+
+	state := &SpecState{}
+	if len(lookupdHTTPAddrs) != 0 {
+		state.Topics, err = c.GetLookupdTopics(lookupdHTTPAddrs)
+	} else {
+		state.Topics, err = c.GetNSQDTopics(nsqdHTTPAddrs)
+	}
+	if err != nil {
+		pe, ok := err.(PartialErr)
+		if !ok {
+			return nil, err
+		}
+		errs = append(errs, pe.Errors()...)
+	}
+
+	if len(lookupdHTTPAddrs) != 0 {
+		state.TopicChannels = make(map[string][]string)
+		for _, topic := range state.Topics {
+			channels, err := c.GetLookupdTopicChannels(topic, lookupdHTTPAddrs)
+			if err != nil {
+				pe, ok := err.(PartialErr)
+				if !ok {
+					return nil, err
+				}
+				errs = append(errs, pe.Errors()...)
+			}
+			state.TopicChannels[topic] = channels
+		}
+	}
+
+	producers, err := c.GetProducers(lookupdHTTPAddrs, nsqdHTTPAddrs)
+	if err != nil {
+		pe, ok := err.(PartialErr)
+		if !ok {
+			return nil, err
+		}
+		errs = append(errs, pe.Errors()...)
+	}
+	state.TopicStats, _, err = c.GetNSQDStats(producers, "", "", false)
+	if err != nil {
+		pe, ok := err.(PartialErr)
+		if !ok {
+			return nil, err
+		}
+		errs = append(errs, pe.Errors()...)
+	}
+
+	if len(errs) > 0 {
+		return state, ErrList(errs)
+	}
+	return state, nil
+}
+
+func findChannelStats(ts *TopicStats, channel string) *ChannelStats {
+	for _, cs := range ts.Channels {
+		if cs.ChannelName == channel {
+			return cs
+		}
+	}
+	return nil
+}
+
+// Plan returns the changes which bring the live state of a cluster in line
+// with the spec: creating the missing topics and channels (including the
+// channels missing on some of the nsqd of their topic) and pausing or
+// unpausing them. With prune the channels of the topics of the spec which it
+// doesn't declare are deleted.
+//
+// Creates are planned first and deletes last, the topics which aren't in the
+// spec are left alone.
+//
+// Topics created through nsqlookupd only reach an nsqd once they are
+// published to, pausing them (or their channels) before that is planned as
+// skipped.
+func (s *Spec) Plan(state *SpecState, prune bool) []SpecChange {
+	var creates, updates, deletes []SpecChange
+
+	known := make(map[string]bool)
+	for _, topic := range state.Topics {
+		known[topic] = true
+	}
+
+	for _, t := range s.Topics {
+		var nodes []*TopicStats
+		for _, ts := range state.TopicStats {
+			if ts.TopicName == t.Name {
+				nodes = append(nodes, ts)
+			}
+		}
+		exists := known[t.Name] || len(nodes) > 0
+		// without nsqlookupd topics are created on every nsqd
+		pending := len(nodes) == 0 && state.TopicChannels != nil
+		if !exists {
+			if t.Ephemeral {
+				continue
+			}
+			creates = append(creates, SpecChange{Action: SpecCreateTopic, Topic: t.Name})
+		}
+
+		var anyPaused, anyUnPaused bool
+		for _, ts := range nodes {
+			anyPaused = anyPaused || ts.Paused
+			anyUnPaused = anyUnPaused || !ts.Paused
+		}
+		if t.Paused && pending {
+			updates = append(updates, SpecChange{Action: SpecPauseTopic, Topic: t.Name, Skipped: specSkipNoNSQD})
+		} else if t.Paused && (anyUnPaused || !exists) {
+			updates = append(updates, SpecChange{Action: SpecPauseTopic, Topic: t.Name})
+		} else if !t.Paused && anyPaused {
+			updates = append(updates, SpecChange{Action: SpecUnPauseTopic, Topic: t.Name})
+		}
+
+		lookupdChannels := make(map[string]bool)
+		for _, channel := range state.TopicChannels[t.Name] {
+			lookupdChannels[channel] = true
+		}
+
+		declared := make(map[string]bool)
+		for _, ch := range t.Channels {
+			declared[ch.Name] = true
+
+			present := lookupdChannels[ch.Name]
+			missing := !present && len(nodes) == 0
+			var anyPaused, anyUnPaused bool
+			for _, ts := range nodes {
+				cs := findChannelStats(ts, ch.Name)
+				if cs == nil {
+					missing = true
+					continue
+				}
+				present = true
+				anyPaused = anyPaused || cs.Paused
+				anyUnPaused = anyUnPaused || !cs.Paused
+			}
+
+			created := false
+			if missing && !ch.Ephemeral {
+				creates = append(creates, SpecChange{Action: SpecCreateChannel, Topic: t.Name, Channel: ch.Name})
+				created = true
+			}
+			if !present && !created {
+				continue
+			}
+			if ch.Paused && pending {
+				updates = append(updates, SpecChange{Action: SpecPauseChannel, Topic: t.Name, Channel: ch.Name,
+					Skipped: specSkipNoNSQD})
+			} else if ch.Paused && (anyUnPaused || created) {
+				updates = append(updates, SpecChange{Action: SpecPauseChannel, Topic: t.Name, Channel: ch.Name})
+			} else if !ch.Paused && anyPaused {
+				updates = append(updates, SpecChange{Action: SpecUnPauseChannel, Topic: t.Name, Channel: ch.Name})
+			}
+		}
+
+		if !prune || !exists {
+			continue
+		}
+		live := make(map[string]bool)
+		for channel := range lookupdChannels {
+			live[channel] = true
+		}
+		for _, ts := range nodes {
+			for _, cs := range ts.Channels {
+				live[cs.ChannelName] = true
+			}
+		}
+		var channels []string
+		for channel := range live {
+			if !declared[channel] && !strings.HasSuffix(channel, ephemeralSuffix) {
+				channels = append(channels, channel)
+			}
+		}
+		sort.Strings(channels)
+		for _, channel := range channels {
+			deletes = append(deletes, SpecChange{Action: SpecDeleteChannel, Topic: t.Name, Channel: channel})
+		}
+	}
+
+	changes := append(creates, updates...)
+	return append(changes, deletes...)
+}
+
+// ApplySpecChange makes a planned change, topics and channels are created
+// through nsqlookupd or, without nsqlookupd, on every nsqd. Skipped changes
+// fail.
+func (c *ClusterInfo) ApplySpecChange(change SpecChange, lookupdHTTPAddrs []string, nsqdHTTPAddrs []string) error {
+	if change.Skipped != "" {
+		return fmt.Errorf("skipped - %s", change.Skipped)
+	}
+	switch change.Action {
+	case SpecCreateTopic, SpecCreateChannel:
+		if len(lookupdHTTPAddrs) != 0 {
+			return c.CreateTopicChannel(change.Topic, change.Channel, lookupdHTTPAddrs)
+		}
+		return c.createNSQDTopicChannel(change.Topic, change.Channel, nsqdHTTPAddrs)
+	case SpecPauseTopic:
+		return c.PauseTopic(change.Topic, lookupdHTTPAddrs, nsqdHTTPAddrs)
+	case SpecUnPauseTopic:
+		return c.UnPauseTopic(change.Topic, lookupdHTTPAddrs, nsqdHTTPAddrs)
+	case SpecPauseChannel:
+		return c.PauseChannel(change.Topic, change.Channel, lookupdHTTPAddrs, nsqdHTTPAddrs)
+	case SpecUnPauseChannel:
+		return c.UnPauseChannel(change.Topic, change.Channel, lookupdHTTPAddrs, nsqdHTTPAddrs)
+	case SpecDeleteChannel:
+		return c.DeleteChannel(change.Topic, change.Channel, lookupdHTTPAddrs, nsqdHTTPAddrs)
+	}
+	return fmt.Errorf("unknown action %q", change.Action)
+}
+
+func (c *ClusterInfo) createNSQDTopicChannel(topicName string, channelName string, nsqdHTTPAddrs []string) error {
+	uri := "topic/create"
+	qs := fmt.Sprintf("topic=%s", url.QueryEscape(topicName))
+	if channelName != "" {
+		uri = "channel/create"
+		qs += fmt.Sprintf("&channel=%s", url.QueryEscape(channelName))
+	}
+
+	var errs []error
+	for _, addr := range nsqdHTTPAddrs {
+		endpoint := fmt.Sprintf("http://%s/%s?%s", addr, uri, qs)
+		c.logf("CI: querying nsqd %s", endpoint)
+		err := c.client.POSTV1(endpoint)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return ErrList(errs)
+	}
+	return nil
+}
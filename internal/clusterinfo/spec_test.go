@@ -0,0 +1,146 @@
+package clusterinfo
+
+import (
+	"testing"
+
+	"github.com/nsqio/nsq/internal/test"
+)
+
+func TestParseSpec(t *testing.T) {
+	spec, err := ParseSpec([]byte(`{"topics": [
+		{"name": "orders", "paused": true, "channels": [
+			{"name": "archive"},
+			{"name": "tail", "ephemeral": true}
+		]},
+		{"name": "scratch#ephemeral"}
+	]}`))
+	test.Nil(t, err)
+	test.Equal(t, 2, len(spec.Topics))
+	test.Equal(t, true, spec.Topics[0].Paused)
+	test.Equal(t, "tail#ephemeral", spec.Topics[0].Channels[1].Name)
+	test.Equal(t, true, spec.Topics[1].Ephemeral)
+
+	for _, s := range []string{
+		`{"topics": [{"name": "orders", "bogus": true}]}`,
+		`{"topics": [{"name": "or ders"}]}`,
+		`{"topics": [{"name": "orders"}, {"name": "orders"}]}`,
+		`{"topics": [{"name": "orders", "channels": [{"name": "a"}, {"name": "a"}]}]}`,
+		`{"topics": [{"name": "orders", "channels": [{"name": "a!"}]}]}`,
+	} {
+		_, err := ParseSpec([]byte(s))
+		test.NotNil(t, err)
+	}
+}
+
+func TestSpecPlan(t *testing.T) {
+	spec, err := ParseSpec([]byte(`{"topics": [
+		{"name": "orders", "channels": [
+			{"name": "archive", "paused": true},
+			{"name": "billing"},
+			{"name": "tail", "ephemeral": true, "paused": true}
+		]},
+		{"name": "payments", "paused": true, "channels": [{"name": "ledger"}]},
+		{"name": "scratch", "ephemeral": true}
+	]}`))
+	test.Nil(t, err)
+
+	// orders is on two nsqd, billing is missing on the second one and is
+	// paused on the first, legacy and the ephemeral channels aren't declared
+	state := &SpecState{
+		Topics: []string{"orders", "unmanaged"},
+		TopicChannels: map[string][]string{
+			"orders": {"archive", "billing", "legacy"},
+		},
+		TopicStats: []*TopicStats{
+			{Node: "a:4151", TopicName: "orders", Channels: []*ChannelStats{
+				{ChannelName: "archive"},
+				{ChannelName: "billing", Paused: true},
+				{ChannelName: "legacy"},
+				{ChannelName: "tail#ephemeral"},
+				{ChannelName: "other#ephemeral"},
+			}},
+			{Node: "b:4151", TopicName: "orders", Channels: []*ChannelStats{
+				{ChannelName: "archive", Paused: true},
+			}},
+			{Node: "a:4151", TopicName: "unmanaged", Channels: []*ChannelStats{
+				{ChannelName: "x"},
+			}},
+		},
+	}
+
+	test.Equal(t, []SpecChange{
+		{Action: SpecCreateChannel, Topic: "orders", Channel: "billing"},
+		{Action: SpecCreateTopic, Topic: "payments"},
+		{Action: SpecCreateChannel, Topic: "payments", Channel: "ledger"},
+		{Action: SpecPauseChannel, Topic: "orders", Channel: "archive"},
+		{Action: SpecUnPauseChannel, Topic: "orders", Channel: "billing"},
+		{Action: SpecPauseChannel, Topic: "orders", Channel: "tail#ephemeral"},
+		{Action: SpecPauseTopic, Topic: "payments", Skipped: specSkipNoNSQD},
+	}, spec.Plan(state, false))
+
+	changes := spec.Plan(state, true)
+	test.Equal(t, SpecChange{Action: SpecDeleteChannel, Topic: "orders", Channel: "legacy"},
+		changes[len(changes)-1])
+	test.Equal(t, 8, len(changes))
+
+	// nothing to do once the cluster matches the spec
+	state = &SpecState{
+		Topics: []string{"orders", "payments"},
+		TopicStats: []*TopicStats{
+			{Node: "a:4151", TopicName: "orders", Channels: []*ChannelStats{
+				{ChannelName: "archive", Paused: true},
+				{ChannelName: "billing"},
+			}},
+			{Node: "a:4151", TopicName: "payments", Paused: true, Channels: []*ChannelStats{
+				{ChannelName: "ledger"},
+			}},
+		},
+	}
+	test.Equal(t, 0, len(spec.Plan(state, true)))
+}
+
+func TestSpecPlanPausePending(t *testing.T) {
+	spec, err := ParseSpec([]byte(`{"topics": [
+		{"name": "orders", "paused": true, "channels": [{"name": "archive", "paused": true}]}
+	]}`))
+	test.Nil(t, err)
+
+	// through nsqlookupd a new topic is on no nsqd, so there is nothing to pause
+	state := &SpecState{TopicChannels: map[string][]string{}}
+	changes := spec.Plan(state, false)
+	test.Equal(t, []SpecChange{
+		{Action: SpecCreateTopic, Topic: "orders"},
+		{Action: SpecCreateChannel, Topic: "orders", Channel: "archive"},
+		{Action: SpecPauseTopic, Topic: "orders", Skipped: specSkipNoNSQD},
+		{Action: SpecPauseChannel, Topic: "orders", Channel: "archive", Skipped: specSkipNoNSQD},
+	}, changes)
+	test.Equal(t, "pause_topic orders (skipped: "+specSkipNoNSQD+")", changes[2].String())
+
+	// a skipped change fails rather than reporting a pause that didn't happen
+	ci := New(nil, nil)
+	err = ci.ApplySpecChange(changes[2], []string{"127.0.0.1:4161"}, nil)
+	test.NotNil(t, err)
+
+	// still skipped while the topic is only known to nsqlookupd
+	state = &SpecState{
+		Topics:        []string{"orders"},
+		TopicChannels: map[string][]string{"orders": {"archive"}},
+	}
+	test.Equal(t, []SpecChange{
+		{Action: SpecPauseTopic, Topic: "orders", Skipped: specSkipNoNSQD},
+		{Action: SpecPauseChannel, Topic: "orders", Channel: "archive", Skipped: specSkipNoNSQD},
+	}, spec.Plan(state, false))
+
+	// and planned once an nsqd has it
+	state.TopicStats = []*TopicStats{
+		{Node: "a:4151", TopicName: "orders", Channels: []*ChannelStats{{ChannelName: "archive"}}},
+	}
+	test.Equal(t, []SpecChange{
+		{Action: SpecPauseTopic, Topic: "orders"},
+		{Action: SpecPauseChannel, Topic: "orders", Channel: "archive"},
+	}, spec.Plan(state, false))
+
+	// without nsqlookupd the topic is created on every nsqd before it is paused
+	changes = spec.Plan(&SpecState{}, false)
+	test.Equal(t, SpecChange{Action: SpecPauseTopic, Topic: "orders"}, changes[2])
+}
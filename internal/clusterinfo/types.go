@@ -22,6 +22,18 @@ func (pt ProducerTopics) Len() int           { return len(pt) }
 func (pt ProducerTopics) Swap(i, j int)      { pt[i], pt[j] = pt[j], pt[i] }
 func (pt ProducerTopics) Less(i, j int) bool { return pt[i].Topic < pt[j].Topic }
 
+// Tombstone is a node tombstoned for a topic on nsqlookupd
+type Tombstone struct {
+	Topic             string `json:"topic"`
+	Node              string `json:"node"`
+	Hostname          string `json:"hostname"`
+	BroadcastAddress  string `json:"broadcast_address"`
+	TCPPort           int    `json:"tcp_port"`
+	HTTPPort          int    `json:"http_port"`
+	TombstonedAt      int64  `json:"tombstoned_at"`
+	RemainingLifetime int64  `json:"remaining_lifetime"`
+}
+
 type Producer struct {
 	RemoteAddresses  []string       `json:"remote_addresses"`
 	RemoteAddress    string         `json:"remote_address"`
@@ -31,6 +43,10 @@ type Producer struct {
 	HTTPPort         int            `json:"http_port"`
 	Version          string         `json:"version"`
 	VersionObj       semver.Version `json:"-"`
+	Zone             string         `json:"zone,omitempty"`
+	Region           string         `json:"region,omitempty"`
+	Rack             string         `json:"rack,omitempty"`
+	Tags             []string       `json:"tags,omitempty"`
 	Topics           ProducerTopics `json:"topics"`
 	OutOfDate        bool           `json:"out_of_date"`
 }
@@ -44,6 +60,10 @@ func (p *Producer) UnmarshalJSON(b []byte) error {
 		TCPPort          int      `json:"tcp_port"`
 		HTTPPort         int      `json:"http_port"`
 		Version          string   `json:"version"`
+		Zone             string   `json:"zone"`
+		Region           string   `json:"region"`
+		Rack             string   `json:"rack"`
+		Tags             []string `json:"tags"`
 		Topics           []string `json:"topics"`
 		Tombstoned       []bool   `json:"tombstones"`
 	}
@@ -57,6 +77,10 @@ func (p *Producer) UnmarshalJSON(b []byte) error {
 		TCPPort:          r.TCPPort,
 		HTTPPort:         r.HTTPPort,
 		Version:          r.Version,
+		Zone:             r.Zone,
+		Region:           r.Region,
+		Rack:             r.Rack,
+		Tags:             r.Tags,
 	}
 	for i, t := range r.Topics {
 		p.Topics = append(p.Topics, ProducerTopic{Topic: t, Tombstoned: r.Tombstoned[i]})
@@ -190,6 +214,7 @@ func (c *ChannelStats) Add(a *ChannelStats) {
 
 type ClientStats struct {
 	Node              string        `json:"node"`
+	ID                int64         `json:"id"`
 	RemoteAddress     string        `json:"remote_address"`
 	Version           string        `json:"version"`
 	ClientID          string        `json:"client_id"`
@@ -0,0 +1,24 @@
+//go:build !windows && !illumos
+// +build !windows,!illumos
+
+package disk
+
+import (
+	"syscall"
+)
+
+// Usage returns the fraction (0 to 1) of the filesystem containing path that
+// is in use, from the point of view of an unprivileged user
+func Usage(path string) (float64, error) {
+	var st syscall.Statfs_t
+	err := syscall.Statfs(path, &st)
+	if err != nil {
+		return 0, err
+	}
+	total := uint64(st.Blocks) * uint64(st.Bsize)
+	if total == 0 {
+		return 0, nil
+	}
+	avail := uint64(st.Bavail) * uint64(st.Bsize)
+	return 1 - float64(avail)/float64(total), nil
+}
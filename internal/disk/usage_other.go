@@ -0,0 +1,13 @@
+//go:build windows || illumos
+// +build windows illumos
+
+package disk
+
+import (
+	"errors"
+)
+
+// Usage is not supported on this platform
+func Usage(path string) (float64, error) {
+	return 0, errors.New("disk usage not supported")
+}
@@ -1,9 +1,12 @@
 package http_api
 
 import (
+	"bytes"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
@@ -31,7 +34,8 @@ func NewDeadlineTransport(connectTimeout time.Duration, requestTimeout time.Dura
 }
 
 type Client struct {
-	c *http.Client
+	c          *http.Client
+	authSecret string
 }
 
 func NewClient(tlsConfig *tls.Config, connectTimeout time.Duration, requestTimeout time.Duration) *Client {
@@ -45,17 +49,40 @@ func NewClient(tlsConfig *tls.Config, connectTimeout time.Duration, requestTimeo
 	}
 }
 
+// WithAuthSecret returns a copy of the client that presents secret as an
+// "Authorization: Bearer" header to daemons with auth enabled
+func (c *Client) WithAuthSecret(secret string) *Client {
+	return &Client{
+		c:          c.c,
+		authSecret: secret,
+	}
+}
+
+func (c *Client) newRequest(method string, endpoint string, body []byte) (*http.Request, error) {
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(method, endpoint, r)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Accept", "application/vnd.nsq; version=1.0")
+	if c.authSecret != "" {
+		req.Header.Set("Authorization", "Bearer "+c.authSecret)
+	}
+	return req, nil
+}
+
 // GETV1 is a helper function to perform a V1 HTTP request
 // and parse our NSQ daemon's expected response format, with deadlines.
 func (c *Client) GETV1(endpoint string, v interface{}) error {
 retry:
-	req, err := http.NewRequest("GET", endpoint, nil)
+	req, err := c.newRequest("GET", endpoint, nil)
 	if err != nil {
 		return err
 	}
 
-	req.Header.Add("Accept", "application/vnd.nsq; version=1.0")
-
 	resp, err := c.c.Do(req)
 	if err != nil {
 		return err
@@ -68,11 +95,12 @@ retry:
 	}
 	if resp.StatusCode != 200 {
 		if resp.StatusCode == 403 && !strings.HasPrefix(endpoint, "https") {
-			endpoint, err = httpsEndpoint(endpoint, body)
-			if err != nil {
-				return err
+			// nsqd responds with its HTTPS port when TLS is required,
+			// any other denial is returned as is
+			if u, err := httpsEndpoint(endpoint, body); err == nil {
+				endpoint = u
+				goto retry
 			}
-			goto retry
 		}
 		return fmt.Errorf("got response %s %q", resp.Status, body)
 	}
@@ -87,13 +115,29 @@ retry:
 // PostV1 is a helper function to perform a V1 HTTP request
 // and parse our NSQ daemon's expected response format, with deadlines.
 func (c *Client) POSTV1(endpoint string) error {
-retry:
-	req, err := http.NewRequest("POST", endpoint, nil)
+	return c.post(endpoint, nil)
+}
+
+// POSTV1JSON is POSTV1 with v encoded as the JSON body of the request
+func (c *Client) POSTV1JSON(endpoint string, v interface{}) error {
+	body, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
+	return c.post(endpoint, body)
+}
 
-	req.Header.Add("Accept", "application/vnd.nsq; version=1.0")
+// POSTV1Body is POSTV1 with body as the raw body of the request
+func (c *Client) POSTV1Body(endpoint string, body []byte) error {
+	return c.post(endpoint, body)
+}
+
+func (c *Client) post(endpoint string, reqBody []byte) error {
+retry:
+	req, err := c.newRequest("POST", endpoint, reqBody)
+	if err != nil {
+		return err
+	}
 
 	resp, err := c.c.Do(req)
 	if err != nil {
@@ -107,11 +151,12 @@ retry:
 	}
 	if resp.StatusCode != 200 {
 		if resp.StatusCode == 403 && !strings.HasPrefix(endpoint, "https") {
-			endpoint, err = httpsEndpoint(endpoint, body)
-			if err != nil {
-				return err
+			// nsqd responds with its HTTPS port when TLS is required,
+			// any other denial is returned as is
+			if u, err := httpsEndpoint(endpoint, body); err == nil {
+				endpoint = u
+				goto retry
 			}
-			goto retry
 		}
 		return fmt.Errorf("got response %s %q", resp.Status, body)
 	}
@@ -127,6 +172,9 @@ func httpsEndpoint(endpoint string, body []byte) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if forbiddenResp.HTTPSPort == 0 {
+		return "", errors.New("no https_port in response")
+	}
 
 	u, err := url.Parse(endpoint)
 	if err != nil {
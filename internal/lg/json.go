@@ -0,0 +1,155 @@
+package lg
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	TextFormat = "text"
+	JSONFormat = "json"
+)
+
+// Fielder is implemented by log arguments that can describe themselves as
+// structured fields (eg. a client reporting its id and remote address)
+type Fielder interface {
+	LogFields() map[string]interface{}
+}
+
+// StructuredLogger is implemented by loggers that want the unformatted
+// message and its arguments rather than a single pre-formatted string
+type StructuredLogger interface {
+	Logger
+	Logf(lvl LogLevel, f string, args ...interface{}) error
+}
+
+// the conventional message prefixes used throughout the daemons
+var fieldPatterns = []struct {
+	name string
+	re   *regexp.Regexp
+}{
+	{"topic", regexp.MustCompile(`(?i)\btopic\(([^)]+)\)`)},
+	{"channel", regexp.MustCompile(`(?i)\bchannel\(([^)]+)\)`)},
+	{"remote_addr", regexp.MustCompile(`(?i)\bclient\(([^)]+)\)`)},
+	{"remote_addr", regexp.MustCompile(`\[(\S+:\d+)\]`)},
+}
+
+var nsqLevels = map[string]LogLevel{
+	"DBG": DEBUG,
+	"INF": INFO,
+	"WRN": WARN,
+	"ERR": ERROR,
+}
+
+// JSONLogger writes one JSON object per line with level, timestamp,
+// component, message and whatever structured fields can be derived
+// from the message and its arguments
+type JSONLogger struct {
+	sync.Mutex
+	w         io.Writer
+	component string
+}
+
+func NewJSONLogger(w io.Writer, component string) *JSONLogger {
+	return &JSONLogger{
+		w:         w,
+		component: component,
+	}
+}
+
+// NewLogger returns a Logger for the given format writing to w,
+// prefix only applies to the text format
+func NewLogger(format string, w io.Writer, prefix string, component string) (Logger, error) {
+	switch format {
+	case TextFormat, "":
+		return log.New(w, prefix, log.Ldate|log.Ltime|log.Lmicroseconds), nil
+	case JSONFormat:
+		return NewJSONLogger(w, component), nil
+	}
+	return nil, fmt.Errorf("invalid log format '%s' (text, json)", format)
+}
+
+func (l *JSONLogger) Logf(lvl LogLevel, f string, args ...interface{}) error {
+	msg := fmt.Sprintf(f, args...)
+	fields := make(map[string]interface{})
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case Fielder:
+			for k, fv := range v.LogFields() {
+				fields[k] = fv
+			}
+		case error:
+			if _, ok := fields["error"]; !ok {
+				fields["error"] = v.Error()
+			}
+		}
+	}
+	for _, p := range fieldPatterns {
+		if _, ok := fields[p.name]; ok {
+			continue
+		}
+		if m := p.re.FindStringSubmatch(msg); m != nil {
+			fields[p.name] = m[1]
+		}
+	}
+	return l.write(lvl.String(), msg, fields)
+}
+
+// Output implements Logger for messages that were formatted elsewhere,
+// the level is recovered from a leading "LEVEL: " if present
+func (l *JSONLogger) Output(maxdepth int, s string) error {
+	lvl := INFO
+	if len(s) > 4 && s[3] == ' ' {
+		// go-nsq style "INF    1 [topic/channel] ..."
+		if parsed, ok := nsqLevels[s[:3]]; ok {
+			return l.write(parsed.String(), strings.TrimSpace(s[4:]), nil)
+		}
+	}
+	if i := strings.Index(s, ": "); i > 0 {
+		prefix := s[:i]
+		if prefix == "WARNING" {
+			prefix = "warn"
+		}
+		if parsed, err := ParseLogLevel(prefix); err == nil {
+			lvl = parsed
+			s = s[i+2:]
+		}
+	}
+	return l.write(lvl.String(), strings.TrimRight(s, "\n"), nil)
+}
+
+// Write allows a JSONLogger to be used as the output of a standard library log.Logger
+func (l *JSONLogger) Write(p []byte) (int, error) {
+	err := l.Output(2, string(p))
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (l *JSONLogger) write(level string, msg string, fields map[string]interface{}) error {
+	entry := make(map[string]interface{}, len(fields)+4)
+	for k, v := range fields {
+		entry[k] = v
+	}
+	entry["ts"] = time.Now().UTC().Format(time.RFC3339Nano)
+	entry["level"] = level
+	entry["component"] = l.component
+	entry["msg"] = msg
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	_, err = l.w.Write(append(data, '\n'))
+	return err
+}
@@ -77,6 +77,10 @@ func Logf(logger Logger, cfgLevel LogLevel, msgLevel LogLevel, f string, args ..
 	if cfgLevel > msgLevel {
 		return
 	}
+	if sl, ok := logger.(StructuredLogger); ok {
+		sl.Logf(msgLevel, f, args...)
+		return
+	}
 	logger.Output(3, fmt.Sprintf(msgLevel.String()+": "+f, args...))
 }
 
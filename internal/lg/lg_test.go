@@ -1,6 +1,9 @@
 package lg
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/nsqio/nsq/internal/test"
@@ -39,3 +42,41 @@ func TestLogging(t *testing.T) {
 	}
 	test.Equal(t, 5, logger.Count)
 }
+
+type fielder struct{}
+
+func (f fielder) LogFields() map[string]interface{} {
+	return map[string]interface{}{"client_id": 7}
+}
+
+func (f fielder) String() string { return "127.0.0.1:4150" }
+
+func TestJSONLogging(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewJSONLogger(&buf, "nsqd")
+
+	Logf(logger, INFO, DEBUG, "filtered")
+	test.Equal(t, 0, buf.Len())
+
+	Logf(logger, INFO, ERROR, "TOPIC(%s): CHANNEL(%s): [%s] failed - %s",
+		"t", "c", fielder{}, errors.New("boom"))
+
+	var entry map[string]interface{}
+	test.Nil(t, json.Unmarshal(buf.Bytes(), &entry))
+	test.Equal(t, "ERROR", entry["level"])
+	test.Equal(t, "nsqd", entry["component"])
+	test.Equal(t, "TOPIC(t): CHANNEL(c): [127.0.0.1:4150] failed - boom", entry["msg"])
+	test.Equal(t, "t", entry["topic"])
+	test.Equal(t, "c", entry["channel"])
+	test.Equal(t, "127.0.0.1:4150", entry["remote_addr"])
+	test.Equal(t, float64(7), entry["client_id"])
+	test.Equal(t, "boom", entry["error"])
+	test.NotNil(t, entry["ts"])
+
+	buf.Reset()
+	logger.Output(2, "WARNING: from elsewhere\n")
+	entry = nil
+	test.Nil(t, json.Unmarshal(buf.Bytes(), &entry))
+	test.Equal(t, "WARNING", entry["level"])
+	test.Equal(t, "from elsewhere", entry["msg"])
+}
@@ -0,0 +1,115 @@
+// Package tracing implements the subset of W3C Trace Context and OTLP
+// needed to carry traces through nsqd
+package tracing
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// TraceparentHeader is the HTTP header carrying W3C trace context
+const TraceparentHeader = "traceparent"
+
+// traceparentLength is the length of a version 00 traceparent value,
+// "00-<32 hex trace id>-<16 hex span id>-<2 hex flags>"
+const traceparentLength = 55
+
+type TraceID [16]byte
+type SpanID [8]byte
+
+// SpanContext identifies a span within a trace
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Flags   byte
+}
+
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID != TraceID{} && sc.SpanID != SpanID{}
+}
+
+// Traceparent formats sc as a version 00 traceparent value
+func (sc SpanContext) Traceparent() string {
+	return fmt.Sprintf("00-%s-%s-%02x",
+		hex.EncodeToString(sc.TraceID[:]), hex.EncodeToString(sc.SpanID[:]), sc.Flags)
+}
+
+// ParseTraceparent parses a W3C traceparent value
+func ParseTraceparent(s string) (SpanContext, error) {
+	var sc SpanContext
+
+	if len(s) < traceparentLength || s[2] != '-' || s[35] != '-' || s[52] != '-' {
+		return sc, errors.New("malformed traceparent")
+	}
+	if len(s) > traceparentLength && (s[:2] == "00" || s[traceparentLength] != '-') {
+		return sc, errors.New("malformed traceparent")
+	}
+	if s[:2] == "ff" {
+		return sc, errors.New("invalid traceparent version")
+	}
+
+	var version [1]byte
+	if _, err := hex.Decode(version[:], []byte(s[:2])); err != nil {
+		return sc, errors.New("invalid traceparent version")
+	}
+	if _, err := hex.Decode(sc.TraceID[:], []byte(s[3:35])); err != nil {
+		return sc, errors.New("invalid trace id")
+	}
+	if _, err := hex.Decode(sc.SpanID[:], []byte(s[36:52])); err != nil {
+		return sc, errors.New("invalid span id")
+	}
+	var flags [1]byte
+	if _, err := hex.Decode(flags[:], []byte(s[53:55])); err != nil {
+		return sc, errors.New("invalid trace flags")
+	}
+	sc.Flags = flags[0]
+
+	if !sc.IsValid() {
+		return sc, errors.New("invalid traceparent")
+	}
+	return sc, nil
+}
+
+func newSpanID() SpanID {
+	var id SpanID
+	rand.Read(id[:])
+	return id
+}
+
+// message headers are an optional prefix on a message body that carries
+// trace context through NSQ alongside the payload:
+//
+//	"\x00traceparent:" <traceparent> "\n" <body>
+//
+// Producers may add one themselves, nsqd only writes them with
+// --trace-message-header. Consumers that see a body starting with a zero
+// byte should strip the header with SplitBody before handling the payload.
+const bodyHeaderPrefix = "\x00traceparent:"
+
+const bodyHeaderLength = len(bodyHeaderPrefix) + traceparentLength + 1
+
+// SplitBody extracts the trace context from a message header, if any, and
+// returns the remaining body
+func SplitBody(body []byte) (SpanContext, []byte, bool) {
+	if len(body) < bodyHeaderLength || !bytes.HasPrefix(body, []byte(bodyHeaderPrefix)) ||
+		body[bodyHeaderLength-1] != '\n' {
+		return SpanContext{}, body, false
+	}
+	sc, err := ParseTraceparent(string(body[len(bodyHeaderPrefix) : bodyHeaderLength-1]))
+	if err != nil {
+		return SpanContext{}, body, false
+	}
+	return sc, body[bodyHeaderLength:], true
+}
+
+// JoinBody prefixes body with a message header carrying sc
+func JoinBody(sc SpanContext, body []byte) []byte {
+	b := make([]byte, 0, bodyHeaderLength+len(body))
+	b = append(b, bodyHeaderPrefix...)
+	b = append(b, sc.Traceparent()...)
+	b = append(b, '\n')
+	return append(b, body...)
+}
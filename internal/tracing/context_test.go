@@ -0,0 +1,42 @@
+package tracing
+
+import (
+	"testing"
+
+	"github.com/nsqio/nsq/internal/test"
+)
+
+func TestParseTraceparent(t *testing.T) {
+	tp := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	sc, err := ParseTraceparent(tp)
+	test.Nil(t, err)
+	test.Equal(t, tp, sc.Traceparent())
+	test.Equal(t, byte(1), sc.Flags)
+
+	for _, bad := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+		"00-xbf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	} {
+		_, err := ParseTraceparent(bad)
+		test.NotNil(t, err)
+	}
+}
+
+func TestBodyHeader(t *testing.T) {
+	sc, _ := ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	_, body, ok := SplitBody([]byte("plain body"))
+	test.Equal(t, false, ok)
+	test.Equal(t, []byte("plain body"), body)
+
+	b := JoinBody(sc, []byte("payload"))
+	got, body, ok := SplitBody(b)
+	test.Equal(t, true, ok)
+	test.Equal(t, sc, got)
+	test.Equal(t, []byte("payload"), body)
+}
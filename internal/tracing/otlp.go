@@ -0,0 +1,208 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nsqio/nsq/internal/lg"
+)
+
+const (
+	exportBatchSize = 512
+	exportInterval  = time.Second
+)
+
+// Exporter batches finished spans and POSTs them to an OTLP/HTTP collector
+// using the JSON encoding
+type Exporter struct {
+	endpoint    string
+	serviceName string
+	client      *http.Client
+	logf        lg.AppLogFunc
+	spanChan    chan *Span
+}
+
+// NewExporter returns an exporter for the collector at endpoint, either a
+// full URL or a <host>:<port> that will have /v1/traces appended
+func NewExporter(endpoint string, serviceName string, client *http.Client, logf lg.AppLogFunc) *Exporter {
+	if !strings.Contains(endpoint, "://") {
+		endpoint = fmt.Sprintf("http://%s/v1/traces", endpoint)
+	}
+	return &Exporter{
+		endpoint:    endpoint,
+		serviceName: serviceName,
+		client:      client,
+		logf:        logf,
+		spanChan:    make(chan *Span, exportBatchSize*4),
+	}
+}
+
+func (e *Exporter) export(s *Span) {
+	select {
+	case e.spanChan <- s:
+	default:
+		// never block the message path on a slow collector
+		e.logf(lg.WARN, "TRACING: export queue full, dropping span %s", s.Name)
+	}
+}
+
+// Run batches and sends spans until exitChan is closed, flushing what is
+// left before returning
+func (e *Exporter) Run(exitChan chan int) {
+	ticker := time.NewTicker(exportInterval)
+	batch := make([]*Span, 0, exportBatchSize)
+	for {
+		select {
+		case s := <-e.spanChan:
+			batch = append(batch, s)
+			if len(batch) < exportBatchSize {
+				continue
+			}
+		case <-ticker.C:
+		case <-exitChan:
+			goto exit
+		}
+		e.send(batch)
+		batch = batch[:0]
+	}
+
+exit:
+	for len(e.spanChan) > 0 {
+		batch = append(batch, <-e.spanChan)
+	}
+	e.send(batch)
+	ticker.Stop()
+	e.logf(lg.INFO, "TRACING: closing")
+}
+
+func (e *Exporter) send(batch []*Span) {
+	if len(batch) == 0 {
+		return
+	}
+
+	data, err := json.Marshal(e.encode(batch))
+	if err != nil {
+		e.logf(lg.ERROR, "TRACING: failed to marshal spans - %s", err)
+		return
+	}
+
+	resp, err := e.client.Post(e.endpoint, "application/json", bytes.NewReader(data))
+	if err != nil {
+		e.logf(lg.ERROR, "TRACING: failed to export %d spans - %s", len(batch), err)
+		return
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != 200 {
+		e.logf(lg.ERROR, "TRACING: failed to export %d spans - got response %s",
+			len(batch), resp.Status)
+	}
+}
+
+type otlpValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+	BoolValue   *bool   `json:"boolValue,omitempty"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpScopeSpans struct {
+	Scope struct {
+		Name string `json:"name"`
+	} `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpResourceSpans struct {
+	Resource struct {
+		Attributes []otlpAttribute `json:"attributes"`
+	} `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpTraces struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+func newAttribute(key string, value interface{}) otlpAttribute {
+	a := otlpAttribute{Key: key}
+	switch v := value.(type) {
+	case bool:
+		a.Value.BoolValue = &v
+	case int:
+		s := strconv.FormatInt(int64(v), 10)
+		a.Value.IntValue = &s
+	case int64:
+		s := strconv.FormatInt(v, 10)
+		a.Value.IntValue = &s
+	case uint16:
+		s := strconv.FormatInt(int64(v), 10)
+		a.Value.IntValue = &s
+	default:
+		s := fmt.Sprint(v)
+		a.Value.StringValue = &s
+	}
+	return a
+}
+
+func (e *Exporter) encode(batch []*Span) otlpTraces {
+	var scope otlpScopeSpans
+	scope.Scope.Name = e.serviceName
+	for _, s := range batch {
+		s.Lock()
+		span := otlpSpan{
+			TraceID:           hex.EncodeToString(s.Context.TraceID[:]),
+			SpanID:            hex.EncodeToString(s.Context.SpanID[:]),
+			Name:              s.Name,
+			Kind:              s.Kind,
+			StartTimeUnixNano: strconv.FormatInt(s.Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(s.End.UnixNano(), 10),
+			Status:            otlpStatus{Code: 1},
+		}
+		if s.Parent != (SpanID{}) {
+			span.ParentSpanID = hex.EncodeToString(s.Parent[:])
+		}
+		for k, v := range s.Attributes {
+			span.Attributes = append(span.Attributes, newAttribute(k, v))
+		}
+		if s.Error != "" {
+			span.Status = otlpStatus{Code: 2, Message: s.Error}
+		}
+		s.Unlock()
+		scope.Spans = append(scope.Spans, span)
+	}
+
+	var rs otlpResourceSpans
+	rs.Resource.Attributes = []otlpAttribute{newAttribute("service.name", e.serviceName)}
+	rs.ScopeSpans = []otlpScopeSpans{scope}
+	return otlpTraces{ResourceSpans: []otlpResourceSpans{rs}}
+}
@@ -0,0 +1,107 @@
+package tracing
+
+import (
+	"sync"
+	"time"
+)
+
+// span kinds, as numbered by OTLP
+const (
+	SpanKindInternal = 1
+	SpanKindServer   = 2
+	SpanKindProducer = 4
+	SpanKindConsumer = 5
+)
+
+const flagSampled = 0x01
+
+// Span is a single timed operation, it is exported when Finish is called
+type Span struct {
+	sync.Mutex
+	tracer *Tracer
+
+	Name       string
+	Kind       int
+	Context    SpanContext
+	Parent     SpanID
+	Start      time.Time
+	End        time.Time
+	Attributes map[string]interface{}
+	Error      string
+}
+
+// SetAttribute records a string, integer or boolean attribute on the span
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	s.Lock()
+	s.Attributes[key] = value
+	s.Unlock()
+}
+
+// SetError marks the span as failed
+func (s *Span) SetError(msg string) {
+	if s == nil {
+		return
+	}
+	s.Lock()
+	s.Error = msg
+	s.Unlock()
+}
+
+// Finish ends the span and hands it to the exporter
+func (s *Span) Finish() {
+	if s == nil {
+		return
+	}
+	s.Lock()
+	s.End = time.Now()
+	s.Unlock()
+	s.tracer.exporter.export(s)
+}
+
+// Tracer creates spans that are exported to an OTLP collector.
+//
+// A nil *Tracer is valid and creates no spans.
+type Tracer struct {
+	exporter *Exporter
+}
+
+func NewTracer(exporter *Exporter) *Tracer {
+	return &Tracer{exporter: exporter}
+}
+
+// Run exports finished spans until exitChan is closed
+func (t *Tracer) Run(exitChan chan int) {
+	t.exporter.Run(exitChan)
+}
+
+// StartSpan starts a child span of parent, nil is returned when there is
+// no valid, sampled parent (nsqd only traces messages that arrive with context)
+func (t *Tracer) StartSpan(name string, kind int, parent SpanContext) *Span {
+	if t == nil || !parent.IsValid() || parent.Flags&flagSampled == 0 {
+		return nil
+	}
+	return &Span{
+		tracer: t,
+		Name:   name,
+		Kind:   kind,
+		Context: SpanContext{
+			TraceID: parent.TraceID,
+			SpanID:  newSpanID(),
+			Flags:   parent.Flags,
+		},
+		Parent:     parent.SpanID,
+		Start:      time.Now(),
+		Attributes: make(map[string]interface{}),
+	}
+}
+
+// SpanContext returns the context of s, the zero value for a nil span
+func (s *Span) SpanContext() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.Context
+}
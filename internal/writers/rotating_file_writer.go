@@ -0,0 +1,99 @@
+package writers
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// RotatingFileWriter appends to a file, rotating it once it grows beyond
+// maxSize bytes. Rotated files are named <path>.1 (most recent) through
+// <path>.<maxBackups>, older ones are removed.
+type RotatingFileWriter struct {
+	sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	f          *os.File
+	size       int64
+}
+
+func NewRotatingFileWriter(path string, maxSize int64, maxBackups int) (*RotatingFileWriter, error) {
+	w := &RotatingFileWriter{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	err := w.open()
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *RotatingFileWriter) open() error {
+	f, err := os.OpenFile(w.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	w.f = f
+	w.size = fi.Size()
+	return nil
+}
+
+func (w *RotatingFileWriter) rotate() error {
+	err := w.f.Close()
+	if err != nil {
+		return err
+	}
+
+	if w.maxBackups <= 0 {
+		os.Remove(w.path)
+	} else {
+		os.Remove(fmt.Sprintf("%s.%d", w.path, w.maxBackups))
+		for i := w.maxBackups - 1; i > 0; i-- {
+			os.Rename(fmt.Sprintf("%s.%d", w.path, i), fmt.Sprintf("%s.%d", w.path, i+1))
+		}
+		err = os.Rename(w.path, w.path+".1")
+		if err != nil {
+			return err
+		}
+	}
+
+	return w.open()
+}
+
+// Write writes p to the current file, rotating first if p would push it past maxSize
+func (w *RotatingFileWriter) Write(p []byte) (int, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.maxSize > 0 && w.size > 0 && w.size+int64(len(p)) > w.maxSize {
+		err := w.rotate()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := w.f.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+// Sync commits the current file to stable storage
+func (w *RotatingFileWriter) Sync() error {
+	w.Lock()
+	defer w.Unlock()
+	return w.f.Sync()
+}
+
+func (w *RotatingFileWriter) Close() error {
+	w.Lock()
+	defer w.Unlock()
+	return w.f.Close()
+}
@@ -0,0 +1,446 @@
+package nsqadmin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nsqio/nsq/internal/clusterinfo"
+	"github.com/nsqio/nsq/internal/quantile"
+)
+
+// alert rule types, each evaluated against the stats of a channel summed
+// across all nsqd
+const (
+	alertDepth        = "depth"         // depth above threshold
+	alertDepthGrowing = "depth_growing" // depth grew at every evaluation
+	alertNoConsumers  = "no_consumers"  // no clients connected
+	alertLatency      = "latency"       // p99 E2E processing latency above threshold milliseconds
+	alertTimeoutRate  = "timeout_rate"  // timeouts per second above threshold
+	alertRequeueRate  = "requeue_rate"  // requeues per second above threshold
+)
+
+const (
+	alertPending  = "pending"
+	alertFiring   = "firing"
+	alertResolved = "resolved"
+)
+
+// AlertRule matches the channels whose topic and channel names match Topic
+// and Channel (glob patterns, empty matches everything) and fires once its
+// condition has held for For
+type AlertRule struct {
+	Name      string  `json:"name"`
+	Type      string  `json:"type"`
+	Topic     string  `json:"topic,omitempty"`
+	Channel   string  `json:"channel,omitempty"`
+	Threshold float64 `json:"threshold"`
+	For       string  `json:"for,omitempty"`
+
+	forDuration time.Duration
+}
+
+func (r *AlertRule) validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("missing name")
+	}
+	switch r.Type {
+	case alertDepth, alertDepthGrowing, alertNoConsumers, alertLatency, alertTimeoutRate, alertRequeueRate:
+	default:
+		return fmt.Errorf("invalid type %q", r.Type)
+	}
+	for _, pattern := range []string{r.Topic, r.Channel} {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q", pattern)
+		}
+	}
+	r.forDuration = 0
+	if r.For != "" {
+		d, err := time.ParseDuration(r.For)
+		if err != nil || d < 0 {
+			return fmt.Errorf("invalid for %q", r.For)
+		}
+		r.forDuration = d
+	}
+	return nil
+}
+
+func (r *AlertRule) matches(topic string, channel string) bool {
+	match := func(pattern string, name string) bool {
+		if pattern == "" {
+			return true
+		}
+		ok, _ := path.Match(pattern, name)
+		return ok
+	}
+	return match(r.Topic, topic) && match(r.Channel, channel)
+}
+
+// Alert is the state of a rule for a channel, it is identified by ID so
+// notifications can be deduplicated
+type Alert struct {
+	ID         string  `json:"id"`
+	Cluster    string  `json:"cluster"`
+	Rule       string  `json:"rule"`
+	Type       string  `json:"type"`
+	Topic      string  `json:"topic"`
+	Channel    string  `json:"channel"`
+	Value      float64 `json:"value"`
+	Threshold  float64 `json:"threshold"`
+	State      string  `json:"state"`
+	Since      int64   `json:"since"`
+	FiredAt    int64   `json:"fired_at,omitempty"`
+	ResolvedAt int64   `json:"resolved_at,omitempty"`
+}
+
+// AlertNotification is POSTed to --alert-webhook-url when an alert fires or
+// resolves
+type AlertNotification struct {
+	Status string `json:"status"`
+	Alert  Alert  `json:"alert"`
+	Via    string `json:"via"`
+}
+
+// channelSample is the previous stats of a channel, to compute rates and growth
+type channelSample struct {
+	at       time.Time
+	depth    int64
+	timeouts int64
+	requeues int64
+}
+
+type alertManager struct {
+	sync.RWMutex
+	nsqadmin *NSQAdmin
+	rules    []*AlertRule
+	alerts   map[string]*Alert
+	samples  map[string]*channelSample
+}
+
+func newAlertManager(n *NSQAdmin) *alertManager {
+	return &alertManager{
+		nsqadmin: n,
+		alerts:   make(map[string]*Alert),
+		samples:  make(map[string]*channelSample),
+	}
+}
+
+// load reads the rules from --alert-rules-file, a JSON list of AlertRule
+func (a *alertManager) load() error {
+	fileName := a.nsqadmin.getOpts().AlertRulesFile
+	if fileName == "" {
+		return nil
+	}
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read alert rules from %s - %s", fileName, err)
+	}
+	var rules []*AlertRule
+	err = json.Unmarshal(data, &rules)
+	if err != nil {
+		return fmt.Errorf("failed to parse alert rules in %s - %s", fileName, err)
+	}
+	names := make(map[string]bool)
+	for _, r := range rules {
+		err = r.validate()
+		if err != nil {
+			return fmt.Errorf("invalid alert rule %q in %s - %s", r.Name, fileName, err)
+		}
+		if names[r.Name] {
+			return fmt.Errorf("duplicate alert rule %q in %s", r.Name, fileName)
+		}
+		names[r.Name] = true
+	}
+	a.Lock()
+	a.rules = rules
+	a.Unlock()
+	return nil
+}
+
+// persist writes the rules back to --alert-rules-file, callers hold the lock
+func (a *alertManager) persist() error {
+	fileName := a.nsqadmin.getOpts().AlertRulesFile
+	if fileName == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(a.rules, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpFileName := fmt.Sprintf("%s.%d.tmp", fileName, rand.Int())
+	err = ioutil.WriteFile(tmpFileName, data, 0600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpFileName, fileName)
+}
+
+func (a *alertManager) getRules() []*AlertRule {
+	a.RLock()
+	defer a.RUnlock()
+	rules := make([]*AlertRule, len(a.rules))
+	copy(rules, a.rules)
+	return rules
+}
+
+// setRule adds a rule or replaces the one with the same name
+func (a *alertManager) setRule(rule *AlertRule) error {
+	err := rule.validate()
+	if err != nil {
+		return err
+	}
+	a.Lock()
+	defer a.Unlock()
+	rules := make([]*AlertRule, 0, len(a.rules)+1)
+	for _, r := range a.rules {
+		if r.Name != rule.Name {
+			rules = append(rules, r)
+		}
+	}
+	a.rules = append(rules, rule)
+	a.dropAlerts(rule.Name)
+	return a.persist()
+}
+
+// deleteRule removes a rule and its alerts, it returns false when no rule
+// has that name
+func (a *alertManager) deleteRule(name string) (bool, error) {
+	a.Lock()
+	defer a.Unlock()
+	rules := make([]*AlertRule, 0, len(a.rules))
+	for _, r := range a.rules {
+		if r.Name != name {
+			rules = append(rules, r)
+		}
+	}
+	if len(rules) == len(a.rules) {
+		return false, nil
+	}
+	a.rules = rules
+	a.dropAlerts(name)
+	return true, a.persist()
+}
+
+// dropAlerts forgets the alerts of a rule without resolving them, callers
+// hold the lock
+func (a *alertManager) dropAlerts(rule string) {
+	for id, alert := range a.alerts {
+		if alert.Rule == rule {
+			delete(a.alerts, id)
+		}
+	}
+}
+
+// activeAlerts returns the pending and firing alerts of a cluster, firing
+// first
+func (a *alertManager) activeAlerts(cluster string) []Alert {
+	a.RLock()
+	defer a.RUnlock()
+	alerts := make([]Alert, 0, len(a.alerts))
+	for _, alert := range a.alerts {
+		if alert.Cluster == cluster {
+			alerts = append(alerts, *alert)
+		}
+	}
+	sort.Slice(alerts, func(i, j int) bool {
+		if alerts[i].State != alerts[j].State {
+			return alerts[i].State == alertFiring
+		}
+		return alerts[i].ID < alerts[j].ID
+	})
+	return alerts
+}
+
+// p99Latency returns the p99 E2E processing latency in milliseconds, when
+// nsqd is configured to track it
+func p99Latency(e2e *quantile.E2eProcessingLatencyAggregate) (float64, bool) {
+	if e2e == nil {
+		return 0, false
+	}
+	for _, p := range e2e.Percentiles {
+		if p["quantile"] == 0.99 {
+			return p["average"] / float64(time.Millisecond), true
+		}
+	}
+	return 0, false
+}
+
+// alertID identifies the alert of a rule for a channel, the alerts of clusters
+// other than the default one are prefixed with the cluster name
+func alertID(cluster string, rule string, topic string, channel string) string {
+	id := fmt.Sprintf("%s/%s/%s", rule, topic, channel)
+	if cluster != defaultClusterName {
+		id = cluster + "/" + id
+	}
+	return id
+}
+
+// evaluate applies every rule to the latest channel stats of a cluster, keyed
+// by "<topic>:<channel>", and returns the alerts which fired or resolved.
+//
+// partial stats are missing some nsqd, the alerts of channels which aren't in
+// them are kept as is rather than resolved.
+func (a *alertManager) evaluate(cluster string, channelStats map[string]*clusterinfo.ChannelStats, partial bool, now time.Time) []*AlertNotification {
+	a.Lock()
+	defer a.Unlock()
+
+	var notifications []*AlertNotification
+	seen := make(map[string]bool)
+	for key, cs := range channelStats {
+		sampleKey := cluster + "/" + key
+		prev := a.samples[sampleKey]
+		a.samples[sampleKey] = &channelSample{
+			at:       now,
+			depth:    cs.Depth,
+			timeouts: cs.TimeoutCount,
+			requeues: cs.RequeueCount,
+		}
+
+		rate := func(count int64, prevCount int64) (float64, bool) {
+			elapsed := now.Sub(prev.at).Seconds()
+			if elapsed <= 0 || count < prevCount {
+				// counters reset when a channel is recreated
+				return 0, false
+			}
+			return float64(count-prevCount) / elapsed, true
+		}
+
+		for _, r := range a.rules {
+			if !r.matches(cs.TopicName, cs.ChannelName) {
+				continue
+			}
+
+			var value float64
+			var ok bool
+			switch r.Type {
+			case alertDepth:
+				value, ok = float64(cs.Depth), float64(cs.Depth) > r.Threshold
+			case alertDepthGrowing:
+				if prev != nil {
+					value, ok = float64(cs.Depth-prev.depth), cs.Depth > prev.depth && float64(cs.Depth) > r.Threshold
+				}
+			case alertNoConsumers:
+				value, ok = float64(cs.ClientCount), cs.ClientCount == 0
+			case alertLatency:
+				value, ok = p99Latency(cs.E2eProcessingLatency)
+				ok = ok && value > r.Threshold
+			case alertTimeoutRate:
+				if prev != nil {
+					value, ok = rate(cs.TimeoutCount, prev.timeouts)
+					ok = ok && value > r.Threshold
+				}
+			case alertRequeueRate:
+				if prev != nil {
+					value, ok = rate(cs.RequeueCount, prev.requeues)
+					ok = ok && value > r.Threshold
+				}
+			}
+
+			id := alertID(cluster, r.Name, cs.TopicName, cs.ChannelName)
+			alert, exists := a.alerts[id]
+			if !ok {
+				if exists {
+					delete(a.alerts, id)
+					if alert.State == alertFiring {
+						alert.State = alertResolved
+						alert.Value = value
+						alert.ResolvedAt = now.Unix()
+						notifications = append(notifications, &AlertNotification{Status: alertResolved, Alert: *alert})
+					}
+				}
+				continue
+			}
+			seen[id] = true
+
+			if !exists {
+				alert = &Alert{
+					ID:        id,
+					Cluster:   cluster,
+					Rule:      r.Name,
+					Type:      r.Type,
+					Topic:     cs.TopicName,
+					Channel:   cs.ChannelName,
+					Threshold: r.Threshold,
+					State:     alertPending,
+					Since:     now.Unix(),
+				}
+				a.alerts[id] = alert
+			}
+			alert.Value = value
+			if alert.State == alertPending && now.Sub(time.Unix(alert.Since, 0)) >= r.forDuration {
+				alert.State = alertFiring
+				alert.FiredAt = now.Unix()
+				notifications = append(notifications, &AlertNotification{Status: alertFiring, Alert: *alert})
+			}
+		}
+	}
+
+	if partial {
+		return notifications
+	}
+
+	// channels which went away resolve their alerts
+	for id, alert := range a.alerts {
+		if seen[id] || alert.Cluster != cluster {
+			continue
+		}
+		if _, ok := channelStats[fmt.Sprintf("%s:%s", alert.Topic, alert.Channel)]; ok {
+			continue
+		}
+		delete(a.alerts, id)
+		if alert.State == alertFiring {
+			alert.State = alertResolved
+			alert.ResolvedAt = now.Unix()
+			notifications = append(notifications, &AlertNotification{Status: alertResolved, Alert: *alert})
+		}
+	}
+	for sampleKey := range a.samples {
+		if !strings.HasPrefix(sampleKey, cluster+"/") {
+			continue
+		}
+		if _, ok := channelStats[strings.TrimPrefix(sampleKey, cluster+"/")]; !ok {
+			delete(a.samples, sampleKey)
+		}
+	}
+
+	return notifications
+}
+
+// evaluateAlerts applies the alert rules to the channel stats of a cluster
+// and notifies --alert-webhook-url of alerts firing or resolving
+func (n *NSQAdmin) evaluateAlerts(c *cluster, channelStats map[string]*clusterinfo.ChannelStats, partial bool,
+	now time.Time, httpclient *http.Client, via string) {
+	for _, notification := range n.alerts.evaluate(c.name, channelStats, partial, now) {
+		n.logf(LOG_INFO, "ALERTS: %s %s", notification.Alert.ID, notification.Status)
+		notification.Via = via
+		content, err := json.Marshal(notification)
+		if err != nil {
+			n.logf(LOG_ERROR, "ALERTS: failed to serialize notification - %s", err)
+			continue
+		}
+		for _, endpoint := range n.getOpts().AlertWebhookURLs {
+			resp, err := httpclient.Post(endpoint, "application/json", bytes.NewBuffer(content))
+			if err != nil {
+				n.logf(LOG_ERROR, "ALERTS: failed to POST notification to %s - %s", endpoint, err)
+				continue
+			}
+			resp.Body.Close()
+			if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+				n.logf(LOG_ERROR, "ALERTS: failed to POST notification to %s - got response %s",
+					endpoint, resp.Status)
+			}
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package nsqadmin
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/nsqio/nsq/internal/writers"
+)
+
+const (
+	// auditMemorySize is how many admin actions are kept when there is no
+	// --audit-log-file
+	auditMemorySize = 1000
+
+	// maxAuditLineSize is the largest line read back from the audit log,
+	// longer ones are skipped
+	maxAuditLineSize = 1024 * 1024
+)
+
+// auditLog records every admin action, appended as a line of JSON to
+// --audit-log-file (rotated past --audit-log-max-size) when it is set or else
+// kept in memory
+type auditLog struct {
+	sync.Mutex
+	fileName   string
+	maxBackups int
+	f          *writers.RotatingFileWriter
+	recent     []*AdminAction
+}
+
+func newAuditLog(fileName string, maxSize int64, maxBackups int) (*auditLog, error) {
+	l := &auditLog{fileName: fileName, maxBackups: maxBackups}
+	if fileName == "" {
+		return l, nil
+	}
+	f, err := writers.NewRotatingFileWriter(fileName, maxSize, maxBackups)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log %s - %s", fileName, err)
+	}
+	l.f = f
+	return l, nil
+}
+
+// append records an action, it is synced to disk before returning
+func (l *auditLog) append(a *AdminAction) error {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.f == nil {
+		l.recent = append(l.recent, a)
+		if len(l.recent) > auditMemorySize {
+			l.recent = l.recent[len(l.recent)-auditMemorySize:]
+		}
+		return nil
+	}
+
+	data, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	_, err = l.f.Write(append(data, '\n'))
+	if err != nil {
+		return err
+	}
+	return l.f.Sync()
+}
+
+// auditFilter selects actions, empty fields and zero times match everything
+type auditFilter struct {
+	Cluster string
+	User    string
+	Topic   string
+	Since   int64
+	Until   int64
+	Limit   int
+}
+
+func (f *auditFilter) matches(a *AdminAction) bool {
+	// actions recorded before clusters were named are on the default one
+	cluster := a.Cluster
+	if cluster == "" {
+		cluster = defaultClusterName
+	}
+	if f.Cluster != "" && cluster != f.Cluster {
+		return false
+	}
+	if f.User != "" && a.User != f.User {
+		return false
+	}
+	if f.Topic != "" && a.Topic != f.Topic {
+		return false
+	}
+	if f.Since != 0 && a.Timestamp < f.Since {
+		return false
+	}
+	if f.Until != 0 && a.Timestamp > f.Until {
+		return false
+	}
+	return true
+}
+
+// query returns the actions matching filter and visible (when visible isn't
+// nil), newest first and at most filter.Limit of them
+func (l *auditLog) query(filter *auditFilter, visible func(*AdminAction) bool) ([]*AdminAction, error) {
+	actions := []*AdminAction{}
+	collect := func(a *AdminAction) {
+		if filter.matches(a) && (visible == nil || visible(a)) {
+			actions = append(actions, a)
+		}
+	}
+
+	l.Lock()
+	if l.f == nil {
+		for _, a := range l.recent {
+			collect(a)
+		}
+		l.Unlock()
+	} else {
+		l.Unlock()
+		// rotated files first, oldest to newest
+		for i := l.maxBackups; i >= 0; i-- {
+			fileName := l.fileName
+			if i > 0 {
+				fileName = fmt.Sprintf("%s.%d", l.fileName, i)
+			}
+			err := readAuditFile(fileName, collect)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for i, j := 0, len(actions)-1; i < j; i, j = i+1, j-1 {
+		actions[i], actions[j] = actions[j], actions[i]
+	}
+	if filter.Limit > 0 && len(actions) > filter.Limit {
+		actions = actions[:filter.Limit]
+	}
+	return actions, nil
+}
+
+// readAuditFile calls fn with each action of an audit log file, in order.
+//
+// Lines which aren't an action, such as a partially written last line, and
+// lines longer than maxAuditLineSize are skipped.
+func readAuditFile(fileName string, fn func(*AdminAction)) error {
+	f, err := os.Open(fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReaderSize(f, maxAuditLineSize)
+	var skip bool
+	for {
+		line, err := r.ReadSlice('\n')
+		if err == bufio.ErrBufferFull {
+			skip = true
+			continue
+		}
+		if !skip && len(line) > 0 {
+			var a AdminAction
+			if json.Unmarshal(line, &a) == nil {
+				fn(&a)
+			}
+		}
+		skip = false
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (l *auditLog) close() error {
+	l.Lock()
+	defer l.Unlock()
+	if l.f == nil {
+		return nil
+	}
+	return l.f.Close()
+}
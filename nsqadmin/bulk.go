@@ -0,0 +1,219 @@
+package nsqadmin
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"regexp"
+	"sort"
+
+	"github.com/nsqio/nsq/internal/clusterinfo"
+)
+
+// bulk action result statuses, matched is only used for a dry run
+const (
+	bulkMatched   = "matched"
+	bulkForbidden = "forbidden"
+	bulkOK        = "ok"
+	bulkWarning   = "warning"
+	bulkError     = "error"
+)
+
+// bulkRequest applies an action to the topics matching any of Topics or, when
+// Channels is set, to the channels of those topics matching any of Channels.
+//
+// Names are glob patterns or, with Regex, regular expressions that must match
+// the whole name.
+type bulkRequest struct {
+	Action   string   `json:"action"`
+	Topics   []string `json:"topics"`
+	Channels []string `json:"channels"`
+	Regex    bool     `json:"regex"`
+	DryRun   bool     `json:"dry_run"`
+
+	matchTopic   nameMatcher
+	matchChannel nameMatcher
+}
+
+type bulkResult struct {
+	Topic   string `json:"topic"`
+	Channel string `json:"channel,omitempty"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// validate checks the request and returns the role needed on each topic to
+// apply its action
+func (r *bulkRequest) validate() (role, error) {
+	var required role
+	switch r.Action {
+	case "pause", "unpause", "empty":
+		required = roleOperator
+	case "delete":
+		required = roleAdmin
+	default:
+		return roleNone, fmt.Errorf("invalid action %q", r.Action)
+	}
+
+	if len(r.Topics) == 0 {
+		return roleNone, fmt.Errorf("missing topics")
+	}
+	var err error
+	r.matchTopic, err = newNameMatcher(r.Topics, r.Regex)
+	if err != nil {
+		return roleNone, err
+	}
+	r.matchChannel, err = newNameMatcher(r.Channels, r.Regex)
+	if err != nil {
+		return roleNone, err
+	}
+	return required, nil
+}
+
+// nameMatcher returns whether a name matches any of its patterns
+type nameMatcher func(name string) bool
+
+func newNameMatcher(patterns []string, regex bool) (nameMatcher, error) {
+	if regex {
+		var res []*regexp.Regexp
+		for _, p := range patterns {
+			re, err := regexp.Compile("^(?:" + p + ")$")
+			if err != nil {
+				return nil, fmt.Errorf("invalid regex %q", p)
+			}
+			res = append(res, re)
+		}
+		return func(name string) bool {
+			for _, re := range res {
+				if re.MatchString(name) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	}
+
+	for _, p := range patterns {
+		if _, err := path.Match(p, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q", p)
+		}
+	}
+	return func(name string) bool {
+		for _, p := range patterns {
+			if ok, _ := path.Match(p, name); ok {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+// bulkTargets returns a result, without a status, for each topic or channel
+// of a cluster a validated request matches
+func (s *httpServer) bulkTargets(c *cluster, r *bulkRequest) ([]bulkResult, error) {
+	var errs []error
+	var err error
+
+	lookupds, nsqds := c.lookupdHTTPAddrs(), c.nsqdHTTPAddrs()
+	var topics []string
+	if len(lookupds) != 0 {
+		topics, err = c.ci.GetLookupdTopics(lookupds)
+	} else {
+		topics, err = c.ci.GetNSQDTopics(nsqds)
+	}
+	if err != nil {
+		pe, ok := err.(clusterinfo.PartialErr)
+		if !ok {
+			return nil, err
+		}
+		errs = append(errs, pe.Errors()...)
+	}
+
+	results := []bulkResult{}
+	for _, topic := range topics {
+		if !r.matchTopic(topic) {
+			continue
+		}
+		if len(r.Channels) == 0 {
+			results = append(results, bulkResult{Topic: topic})
+			continue
+		}
+
+		producers, err := c.ci.GetTopicProducers(topic, lookupds, nsqds)
+		if err != nil {
+			pe, ok := err.(clusterinfo.PartialErr)
+			if !ok {
+				errs = append(errs, err)
+				continue
+			}
+			errs = append(errs, pe.Errors()...)
+		}
+		_, channelStats, err := c.ci.GetNSQDStats(producers, topic, "", false)
+		if err != nil {
+			pe, ok := err.(clusterinfo.PartialErr)
+			if !ok {
+				errs = append(errs, err)
+				continue
+			}
+			errs = append(errs, pe.Errors()...)
+		}
+		for channel := range channelStats {
+			if r.matchChannel(channel) {
+				results = append(results, bulkResult{Topic: topic, Channel: channel})
+			}
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Topic != results[j].Topic {
+			return results[i].Topic < results[j].Topic
+		}
+		return results[i].Channel < results[j].Channel
+	})
+
+	if len(errs) > 0 {
+		return results, clusterinfo.ErrList(errs)
+	}
+	return results, nil
+}
+
+// bulkApply applies an action to one topic or channel of a cluster, the same
+// way the single topic and channel actions do
+func (s *httpServer) bulkApply(c *cluster, action string, topic string, channel string, req *http.Request) error {
+	lookupds, nsqds := c.lookupdHTTPAddrs(), c.nsqdHTTPAddrs()
+
+	var err error
+	switch action {
+	case "pause":
+		if channel != "" {
+			err = c.ci.PauseChannel(topic, channel, lookupds, nsqds)
+		} else {
+			err = c.ci.PauseTopic(topic, lookupds, nsqds)
+		}
+	case "unpause":
+		if channel != "" {
+			err = c.ci.UnPauseChannel(topic, channel, lookupds, nsqds)
+		} else {
+			err = c.ci.UnPauseTopic(topic, lookupds, nsqds)
+		}
+	case "empty":
+		if channel != "" {
+			err = c.ci.EmptyChannel(topic, channel, lookupds, nsqds)
+		} else {
+			err = c.ci.EmptyTopic(topic, lookupds, nsqds)
+		}
+	case "delete":
+		if channel != "" {
+			err = c.ci.DeleteChannel(topic, channel, lookupds, nsqds)
+		} else {
+			err = c.ci.DeleteTopic(topic, lookupds, nsqds)
+		}
+	}
+
+	if channel != "" {
+		s.notifyAdminAction(action+"_channel", topic, channel, "", req)
+	} else {
+		s.notifyAdminAction(action+"_topic", topic, "", "", req)
+	}
+	return err
+}
@@ -0,0 +1,329 @@
+package nsqadmin
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/nsqio/nsq/internal/clusterinfo"
+	"github.com/nsqio/nsq/internal/http_api"
+)
+
+// defaultClusterName is the name of the cluster given by the top level
+// --lookupd-http-address or --nsqd-http-address
+const defaultClusterName = "default"
+
+var validClusterName = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// clusterOptions are the addresses and HTTP client settings of a cluster
+type clusterOptions struct {
+	Name                            string
+	NSQLookupdHTTPAddresses         []string
+	NSQDHTTPAddresses               []string
+	HTTPClientTLSInsecureSkipVerify bool
+	HTTPClientTLSRootCAFile         string
+	HTTPClientTLSCert               string
+	HTTPClientTLSKey                string
+	HTTPClientAuthSecret            string
+}
+
+// parseClusterOptions parses a --cluster given as NAME:KEY=VALUE[,KEY=VALUE...]
+// where each key is the top level flag it sets for that cluster, the HTTP
+// client settings not given default to the top level ones
+func parseClusterOptions(s string, opts *Options) (*clusterOptions, error) {
+	idx := strings.Index(s, ":")
+	if idx == -1 {
+		return nil, fmt.Errorf("invalid cluster %q (expected NAME:KEY=VALUE[,KEY=VALUE...])", s)
+	}
+	c := &clusterOptions{
+		Name:                            s[:idx],
+		HTTPClientTLSInsecureSkipVerify: opts.HTTPClientTLSInsecureSkipVerify,
+		HTTPClientTLSRootCAFile:         opts.HTTPClientTLSRootCAFile,
+		HTTPClientTLSCert:               opts.HTTPClientTLSCert,
+		HTTPClientTLSKey:                opts.HTTPClientTLSKey,
+		HTTPClientAuthSecret:            opts.HTTPClientAuthSecret,
+	}
+	if !validClusterName.MatchString(c.Name) {
+		return nil, fmt.Errorf("invalid cluster %q - invalid name %q", s, c.Name)
+	}
+
+	for _, kv := range strings.Split(s[idx+1:], ",") {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid cluster %q - expected KEY=VALUE not %q", s, kv)
+		}
+		key, value := parts[0], parts[1]
+		switch key {
+		case "lookupd-http-address":
+			c.NSQLookupdHTTPAddresses = append(c.NSQLookupdHTTPAddresses, value)
+		case "nsqd-http-address":
+			c.NSQDHTTPAddresses = append(c.NSQDHTTPAddresses, value)
+		case "http-client-tls-insecure-skip-verify":
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cluster %q - invalid %s %q", s, key, value)
+			}
+			c.HTTPClientTLSInsecureSkipVerify = b
+		case "http-client-tls-root-ca-file":
+			c.HTTPClientTLSRootCAFile = value
+		case "http-client-tls-cert":
+			c.HTTPClientTLSCert = value
+		case "http-client-tls-key":
+			c.HTTPClientTLSKey = value
+		case "http-client-auth-secret":
+			c.HTTPClientAuthSecret = value
+		default:
+			return nil, fmt.Errorf("invalid cluster %q - unknown key %q", s, key)
+		}
+	}
+
+	if len(c.NSQDHTTPAddresses) == 0 && len(c.NSQLookupdHTTPAddresses) == 0 {
+		return nil, fmt.Errorf("invalid cluster %q - nsqd-http-address or lookupd-http-address required", s)
+	}
+	if len(c.NSQDHTTPAddresses) != 0 && len(c.NSQLookupdHTTPAddresses) != 0 {
+		return nil, fmt.Errorf("invalid cluster %q - use nsqd-http-address or lookupd-http-address not both", s)
+	}
+	return c, nil
+}
+
+// newHTTPClientTLSConfig builds the TLS config of the HTTP client used to
+// query a cluster
+func newHTTPClientTLSConfig(c *clusterOptions) (*tls.Config, error) {
+	if c.HTTPClientTLSCert != "" && c.HTTPClientTLSKey == "" {
+		return nil, errors.New("--http-client-tls-key must be specified with --http-client-tls-cert")
+	}
+
+	if c.HTTPClientTLSKey != "" && c.HTTPClientTLSCert == "" {
+		return nil, errors.New("--http-client-tls-cert must be specified with --http-client-tls-key")
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.HTTPClientTLSInsecureSkipVerify,
+	}
+	if c.HTTPClientTLSCert != "" && c.HTTPClientTLSKey != "" {
+		cert, err := tls.LoadX509KeyPair(c.HTTPClientTLSCert, c.HTTPClientTLSKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to LoadX509KeyPair %s, %s - %s",
+				c.HTTPClientTLSCert, c.HTTPClientTLSKey, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	if c.HTTPClientTLSRootCAFile != "" {
+		tlsCertPool := x509.NewCertPool()
+		caCertFile, err := ioutil.ReadFile(c.HTTPClientTLSRootCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read TLS root CA file %s - %s",
+				c.HTTPClientTLSRootCAFile, err)
+		}
+		if !tlsCertPool.AppendCertsFromPEM(caCertFile) {
+			return nil, fmt.Errorf("failed to AppendCertsFromPEM %s", c.HTTPClientTLSRootCAFile)
+		}
+		tlsConfig.RootCAs = tlsCertPool
+	}
+
+	for _, address := range c.NSQLookupdHTTPAddresses {
+		_, err := net.ResolveTCPAddr("tcp", address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve --lookupd-http-address (%s) - %s", address, err)
+		}
+	}
+
+	for _, address := range c.NSQDHTTPAddresses {
+		_, err := net.ResolveTCPAddr("tcp", address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve --nsqd-http-address (%s) - %s", address, err)
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// cluster is an NSQ cluster managed by nsqadmin, with its own addresses and
+// HTTP client
+type cluster struct {
+	name      string
+	tlsConfig *tls.Config
+	ci        *clusterinfo.ClusterInfo
+	history   *historyStore
+
+	// the default cluster reads its addresses from the options, which
+	// /config can change at runtime
+	nsqadmin *NSQAdmin
+	lookupds []string
+	nsqds    []string
+}
+
+func newCluster(n *NSQAdmin, c *clusterOptions) (*cluster, error) {
+	tlsConfig, err := newHTTPClientTLSConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	opts := n.getOpts()
+	client := http_api.NewClient(tlsConfig, opts.HTTPClientConnectTimeout, opts.HTTPClientRequestTimeout)
+	ci := clusterinfo.New(n.logf, client)
+	if c.HTTPClientAuthSecret != "" {
+		ci = ci.WithAuthSecret(c.HTTPClientAuthSecret)
+	}
+	return &cluster{
+		name:      c.Name,
+		tlsConfig: tlsConfig,
+		ci:        ci,
+		lookupds:  c.NSQLookupdHTTPAddresses,
+		nsqds:     c.NSQDHTTPAddresses,
+	}, nil
+}
+
+func (c *cluster) lookupdHTTPAddrs() []string {
+	if c.nsqadmin != nil {
+		return c.nsqadmin.getOpts().NSQLookupdHTTPAddresses
+	}
+	return c.lookupds
+}
+
+func (c *cluster) nsqdHTTPAddrs() []string {
+	if c.nsqadmin != nil {
+		return c.nsqadmin.getOpts().NSQDHTTPAddresses
+	}
+	return c.nsqds
+}
+
+// newClusters builds the default cluster from the top level options, if any
+// addresses are given, followed by each --cluster
+func newClusters(n *NSQAdmin, opts *Options) ([]*cluster, error) {
+	var clusters []*cluster
+	if len(opts.NSQDHTTPAddresses) != 0 || len(opts.NSQLookupdHTTPAddresses) != 0 {
+		if len(opts.NSQDHTTPAddresses) != 0 && len(opts.NSQLookupdHTTPAddresses) != 0 {
+			return nil, errors.New("use --nsqd-http-address or --lookupd-http-address not both")
+		}
+		c, err := newCluster(n, &clusterOptions{
+			Name:                            defaultClusterName,
+			NSQLookupdHTTPAddresses:         opts.NSQLookupdHTTPAddresses,
+			NSQDHTTPAddresses:               opts.NSQDHTTPAddresses,
+			HTTPClientTLSInsecureSkipVerify: opts.HTTPClientTLSInsecureSkipVerify,
+			HTTPClientTLSRootCAFile:         opts.HTTPClientTLSRootCAFile,
+			HTTPClientTLSCert:               opts.HTTPClientTLSCert,
+			HTTPClientTLSKey:                opts.HTTPClientTLSKey,
+			HTTPClientAuthSecret:            opts.HTTPClientAuthSecret,
+		})
+		if err != nil {
+			return nil, err
+		}
+		c.nsqadmin = n
+		clusters = append(clusters, c)
+	}
+
+	for _, s := range opts.Clusters {
+		co, err := parseClusterOptions(s, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range clusters {
+			if c.name == co.Name {
+				return nil, fmt.Errorf("duplicate cluster %q", co.Name)
+			}
+		}
+		c, err := newCluster(n, co)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure cluster %q - %s", co.Name, err)
+		}
+		clusters = append(clusters, c)
+	}
+
+	if len(clusters) == 0 {
+		return nil, errors.New("--nsqd-http-address or --lookupd-http-address required")
+	}
+	return clusters, nil
+}
+
+// getCluster returns the cluster with the given name or, with an empty name,
+// the first one
+func (n *NSQAdmin) getCluster(name string) *cluster {
+	if name == "" {
+		return n.clusters[0]
+	}
+	for _, c := range n.clusters {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+type clusterContextKey struct{}
+
+// inCluster resolves the :cluster of a route, or the default cluster for the
+// routes outside of /clusters/, for requestCluster
+func (s *httpServer) inCluster(f http_api.APIHandler) http_api.APIHandler {
+	return func(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+		c := s.nsqadmin.getCluster(ps.ByName("cluster"))
+		if c == nil {
+			return nil, http_api.Err{404, "CLUSTER_NOT_FOUND"}
+		}
+		ctx := context.WithValue(req.Context(), clusterContextKey{}, c)
+		return f(w, req.WithContext(ctx), ps)
+	}
+}
+
+// requestCluster returns the cluster a request is for
+func (s *httpServer) requestCluster(req *http.Request) *cluster {
+	if c, ok := req.Context().Value(clusterContextKey{}).(*cluster); ok {
+		return c
+	}
+	return s.nsqadmin.clusters[0]
+}
+
+// clusterSummary is the overview of a cluster listed by /api/clusters
+type clusterSummary struct {
+	Name         string   `json:"name"`
+	NSQLookupd   []string `json:"nsqlookupd_http_addresses"`
+	NSQD         []string `json:"nsqd_http_addresses"`
+	Nodes        int      `json:"nodes"`
+	Topics       int      `json:"topics"`
+	Depth        int64    `json:"depth"`
+	MessageCount int64    `json:"message_count"`
+	Message      string   `json:"message"`
+}
+
+// summary counts the nodes of a cluster and the topics on them and sums the
+// depth and message count of those topics, errors are returned as a warning
+func (c *cluster) summary() *clusterSummary {
+	var messages []string
+	s := &clusterSummary{
+		Name:       c.name,
+		NSQLookupd: c.lookupdHTTPAddrs(),
+		NSQD:       c.nsqdHTTPAddrs(),
+	}
+
+	producers, err := c.ci.GetProducers(s.NSQLookupd, s.NSQD)
+	if err != nil {
+		messages = append(messages, err.Error())
+	}
+	s.Nodes = len(producers)
+	if len(producers) == 0 {
+		s.Message = maybeWarnMsg(messages)
+		return s
+	}
+
+	topicStats, _, err := c.ci.GetNSQDStats(producers, "", "", false)
+	if err != nil {
+		messages = append(messages, err.Error())
+	}
+	topics := make(map[string]bool)
+	for _, ts := range topicStats {
+		topics[ts.TopicName] = true
+		s.Depth += ts.Depth
+		s.MessageCount += ts.MessageCount
+	}
+	s.Topics = len(topics)
+	s.Message = maybeWarnMsg(messages)
+	return s
+}
@@ -0,0 +1,251 @@
+package nsqadmin
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/nsqio/nsq/internal/clusterinfo"
+)
+
+// history series types, a series is either for a single nsqd or, with node
+// "*", for the whole cluster
+const (
+	historyTopic   = "topic"
+	historyChannel = "channel"
+	historyNode    = "node"
+)
+
+// historyCounters are sampled as counters and stored as a per second rate
+var historyCounters = map[string]bool{
+	"message_count": true,
+	"requeue_count": true,
+	"timeout_count": true,
+}
+
+type seriesKey struct {
+	typ     string
+	node    string
+	topic   string
+	channel string
+	metric  string
+}
+
+// series holds the values of a metric at each sample of the store, NaN when
+// it wasn't sampled (or, for a counter, when no rate could be computed)
+type series struct {
+	values  []float64
+	counter float64
+	hasPrev bool
+}
+
+// historyStore is a fixed size ring buffer of samples of the cluster stats.
+//
+// All series share the timestamps of the store so each one only costs a
+// float64 per sample, series with no value left in the buffer are dropped.
+type historyStore struct {
+	sync.RWMutex
+	size   int
+	times  []int64
+	next   int
+	series map[seriesKey]*series
+}
+
+func newHistoryStore(size int) *historyStore {
+	return &historyStore{
+		size:   size,
+		times:  make([]int64, 0, size),
+		series: make(map[seriesKey]*series),
+	}
+}
+
+// historySample collects the values of one round of sampling
+type historySample map[seriesKey]float64
+
+func (s historySample) add(k seriesKey, v float64) {
+	s[k] += v
+}
+
+func (s historySample) max(k seriesKey, v float64) {
+	if prev, ok := s[k]; !ok || v > prev {
+		s[k] = v
+	}
+}
+
+// newHistorySample aggregates the per nsqd topic stats into the series of
+// each topic, channel and node, both per nsqd and cluster wide
+func newHistorySample(topicStats []*clusterinfo.TopicStats) historySample {
+	s := make(historySample)
+	for _, ts := range topicStats {
+		for _, node := range []string{ts.Node, "*"} {
+			topic := seriesKey{typ: historyTopic, node: node, topic: ts.TopicName}
+			nodeKey := seriesKey{typ: historyNode, node: node}
+
+			for metric, v := range map[string]float64{
+				"depth":         float64(ts.Depth),
+				"message_count": float64(ts.MessageCount),
+			} {
+				topic.metric, nodeKey.metric = metric, metric
+				s.add(topic, v)
+				s.add(nodeKey, v)
+			}
+			if v, ok := p99Latency(ts.E2eProcessingLatency); ok {
+				topic.metric = "e2e_processing_latency"
+				s.max(topic, v)
+			}
+
+			for _, cs := range ts.Channels {
+				channel := seriesKey{typ: historyChannel, node: node, topic: ts.TopicName, channel: cs.ChannelName}
+				for metric, v := range map[string]float64{
+					"depth":           float64(cs.Depth),
+					"in_flight_count": float64(cs.InFlightCount),
+					"deferred_count":  float64(cs.DeferredCount),
+					"requeue_count":   float64(cs.RequeueCount),
+					"timeout_count":   float64(cs.TimeoutCount),
+					"message_count":   float64(cs.MessageCount),
+					"clients":         float64(cs.ClientCount),
+				} {
+					channel.metric = metric
+					s.add(channel, v)
+				}
+				for metric, v := range map[string]float64{
+					"depth":           float64(cs.Depth),
+					"in_flight_count": float64(cs.InFlightCount),
+					"clients":         float64(cs.ClientCount),
+				} {
+					nodeKey.metric = metric
+					s.add(nodeKey, v)
+				}
+				if v, ok := p99Latency(cs.E2eProcessingLatency); ok {
+					channel.metric = "e2e_processing_latency"
+					s.max(channel, v)
+				}
+			}
+		}
+	}
+	return s
+}
+
+// record appends a sample taken at now, overwriting the oldest one once the
+// buffer is full
+func (h *historyStore) record(sample historySample, now time.Time) {
+	h.Lock()
+	defer h.Unlock()
+
+	var prevAt int64
+	idx := h.next
+	if len(h.times) < h.size {
+		if len(h.times) > 0 {
+			prevAt = h.times[len(h.times)-1]
+		}
+		idx = len(h.times)
+		h.times = append(h.times, now.UnixNano())
+	} else {
+		prevAt = h.times[(h.next+h.size-1)%h.size]
+		h.times[idx] = now.UnixNano()
+		h.next = (h.next + 1) % h.size
+	}
+	elapsed := time.Duration(now.UnixNano() - prevAt).Seconds()
+
+	for k := range sample {
+		if _, ok := h.series[k]; !ok {
+			values := make([]float64, h.size)
+			for i := range values {
+				values[i] = math.NaN()
+			}
+			h.series[k] = &series{values: values}
+		}
+	}
+
+	for k, s := range h.series {
+		v, ok := sample[k]
+		if ok && historyCounters[k.metric] {
+			// counters reset when a topic or channel is recreated
+			counter := v
+			ok = s.hasPrev && prevAt != 0 && elapsed > 0 && counter >= s.counter
+			v = (counter - s.counter) / elapsed
+			s.counter = counter
+			s.hasPrev = true
+		} else if !ok {
+			s.hasPrev = false
+		}
+		if !ok {
+			v = math.NaN()
+		}
+		s.values[idx] = v
+
+		empty := true
+		for _, x := range s.values {
+			if !math.IsNaN(x) {
+				empty = false
+				break
+			}
+		}
+		if empty && !s.hasPrev {
+			delete(h.series, k)
+		}
+	}
+}
+
+// get returns the [timestamp, value] points of a series since the given time,
+// timestamps are in seconds
+func (h *historyStore) get(k seriesKey, since time.Time) [][2]float64 {
+	h.RLock()
+	defer h.RUnlock()
+	points := [][2]float64{}
+	s, ok := h.series[k]
+	if !ok {
+		return points
+	}
+	for i := 0; i < len(h.times); i++ {
+		idx := (h.next + i) % len(h.times)
+		if h.times[idx] < since.UnixNano() || math.IsNaN(s.values[idx]) {
+			continue
+		}
+		points = append(points, [2]float64{float64(h.times[idx] / int64(time.Second)), s.values[idx]})
+	}
+	return points
+}
+
+// metrics returns the names of the metrics a topic, channel or node has
+func (h *historyStore) metrics(k seriesKey) []string {
+	h.RLock()
+	defer h.RUnlock()
+	var metrics []string
+	for sk := range h.series {
+		if sk.typ == k.typ && sk.node == k.node && sk.topic == k.topic && sk.channel == k.channel {
+			metrics = append(metrics, sk.metric)
+		}
+	}
+	sort.Strings(metrics)
+	return metrics
+}
+
+// renderSVG draws points as a line, scaled to fit width and height from 0
+func renderSVG(points [][2]float64, width int, height int, color string) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`,
+		width, height, width, height)
+	if len(points) > 1 && points[len(points)-1][0] > points[0][0] {
+		minT, maxT := points[0][0], points[len(points)-1][0]
+		maxV := 0.0
+		for _, p := range points {
+			maxV = math.Max(maxV, p[1])
+		}
+		buf.WriteString(`<polyline fill="none" stroke="` + color + `" stroke-width="1" points="`)
+		for _, p := range points {
+			x := float64(width) * (p[0] - minT) / (maxT - minT)
+			y := float64(height - 1)
+			if maxV > 0 {
+				y -= float64(height-2) * p[1] / maxV
+			}
+			fmt.Fprintf(&buf, "%.1f,%.1f ", x, y)
+		}
+		buf.WriteString(`"/>`)
+	}
+	buf.WriteString(`</svg>`)
+	return buf.Bytes()
+}
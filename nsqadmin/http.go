@@ -13,7 +13,9 @@ import (
 	"net/url"
 	"path"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/julienschmidt/httprouter"
@@ -51,7 +53,6 @@ type httpServer struct {
 	nsqadmin     *NSQAdmin
 	router       http.Handler
 	client       *http_api.Client
-	ci           *clusterinfo.ClusterInfo
 	basePath     string
 	devStaticDir string
 }
@@ -59,7 +60,7 @@ type httpServer struct {
 func NewHTTPServer(nsqadmin *NSQAdmin) *httpServer {
 	log := http_api.Log(nsqadmin.logf)
 
-	client := http_api.NewClient(nsqadmin.httpClientTLSConfig, nsqadmin.getOpts().HTTPClientConnectTimeout,
+	client := http_api.NewClient(nsqadmin.clusters[0].tlsConfig, nsqadmin.getOpts().HTTPClientConnectTimeout,
 		nsqadmin.getOpts().HTTPClientRequestTimeout)
 
 	router := httprouter.New()
@@ -72,7 +73,6 @@ func NewHTTPServer(nsqadmin *NSQAdmin) *httpServer {
 		nsqadmin: nsqadmin,
 		router:   router,
 		client:   client,
-		ci:       clusterinfo.New(nsqadmin.logf, client),
 
 		basePath:     nsqadmin.getOpts().BasePath,
 		devStaticDir: nsqadmin.getOpts().DevStaticDir,
@@ -81,17 +81,27 @@ func NewHTTPServer(nsqadmin *NSQAdmin) *httpServer {
 	bp := func(p string) string {
 		return path.Join(s.basePath, p)
 	}
+	// pages and API endpoints are for the default cluster and, under
+	// /clusters/:cluster, for each cluster
+	handle := func(method string, p string, h httprouter.Handle) {
+		router.Handle(method, bp(p), h)
+		router.Handle(method, bp("/clusters/:cluster"+p), h)
+	}
 
-	router.Handle("GET", bp("/"), http_api.Decorate(s.indexHandler, log))
 	router.Handle("GET", bp("/ping"), http_api.Decorate(s.pingHandler, log, http_api.PlainText))
 
-	router.Handle("GET", bp("/topics"), http_api.Decorate(s.indexHandler, log))
-	router.Handle("GET", bp("/topics/:topic"), http_api.Decorate(s.indexHandler, log))
-	router.Handle("GET", bp("/topics/:topic/:channel"), http_api.Decorate(s.indexHandler, log))
-	router.Handle("GET", bp("/nodes"), http_api.Decorate(s.indexHandler, log))
-	router.Handle("GET", bp("/nodes/:node"), http_api.Decorate(s.indexHandler, log))
-	router.Handle("GET", bp("/counter"), http_api.Decorate(s.indexHandler, log))
-	router.Handle("GET", bp("/lookup"), http_api.Decorate(s.indexHandler, log))
+	handle("GET", "/", http_api.Decorate(s.indexHandler, log))
+	handle("GET", "/topics", http_api.Decorate(s.indexHandler, log))
+	handle("GET", "/topics/:topic", http_api.Decorate(s.indexHandler, log))
+	handle("GET", "/topics/:topic/:channel", http_api.Decorate(s.indexHandler, log))
+	handle("GET", "/nodes", http_api.Decorate(s.indexHandler, log))
+	handle("GET", "/nodes/:node", http_api.Decorate(s.indexHandler, log))
+	handle("GET", "/counter", http_api.Decorate(s.indexHandler, log))
+	handle("GET", "/lookup", http_api.Decorate(s.indexHandler, log))
+	handle("GET", "/alerts", http_api.Decorate(s.indexHandler, log))
+	handle("GET", "/bulk", http_api.Decorate(s.indexHandler, log))
+	handle("GET", "/audit", http_api.Decorate(s.indexHandler, log))
+	router.Handle("GET", bp("/clusters"), http_api.Decorate(s.indexHandler, log))
 
 	router.Handle("GET", bp("/static/:asset"), http_api.Decorate(s.staticAssetHandler, log, http_api.PlainText))
 	router.Handle("GET", bp("/fonts/:asset"), http_api.Decorate(s.staticAssetHandler, log, http_api.PlainText))
@@ -102,26 +112,44 @@ func NewHTTPServer(nsqadmin *NSQAdmin) *httpServer {
 	}
 
 	// v1 endpoints
-	router.Handle("GET", bp("/api/topics"), http_api.Decorate(s.topicsHandler, log, http_api.V1))
-	router.Handle("GET", bp("/api/topics/:topic"), http_api.Decorate(s.topicHandler, log, http_api.V1))
-	router.Handle("GET", bp("/api/topics/:topic/:channel"), http_api.Decorate(s.channelHandler, log, http_api.V1))
-	router.Handle("GET", bp("/api/nodes"), http_api.Decorate(s.nodesHandler, log, http_api.V1))
-	router.Handle("GET", bp("/api/nodes/:node"), http_api.Decorate(s.nodeHandler, log, http_api.V1))
-	router.Handle("POST", bp("/api/topics"), http_api.Decorate(s.createTopicChannelHandler, log, http_api.V1))
-	router.Handle("POST", bp("/api/topics/:topic"), http_api.Decorate(s.topicActionHandler, log, http_api.V1))
-	router.Handle("POST", bp("/api/topics/:topic/:channel"), http_api.Decorate(s.channelActionHandler, log, http_api.V1))
-	router.Handle("DELETE", bp("/api/nodes/:node"), http_api.Decorate(s.tombstoneNodeForTopicHandler, log, http_api.V1))
-	router.Handle("DELETE", bp("/api/topics/:topic"), http_api.Decorate(s.deleteTopicHandler, log, http_api.V1))
-	router.Handle("DELETE", bp("/api/topics/:topic/:channel"), http_api.Decorate(s.deleteChannelHandler, log, http_api.V1))
-	router.Handle("GET", bp("/api/counter"), http_api.Decorate(s.counterHandler, log, http_api.V1))
-	router.Handle("GET", bp("/api/graphite"), http_api.Decorate(s.graphiteHandler, log, http_api.V1))
+	handle("GET", "/api/topics", http_api.Decorate(s.topicsHandler, s.inCluster, log, http_api.V1))
+	handle("GET", "/api/topics/:topic", http_api.Decorate(s.topicHandler, s.inCluster, log, http_api.V1))
+	handle("GET", "/api/topics/:topic/:channel", http_api.Decorate(s.channelHandler, s.inCluster, log, http_api.V1))
+	handle("GET", "/api/nodes", http_api.Decorate(s.nodesHandler, s.inCluster, log, http_api.V1))
+	handle("GET", "/api/nodes/:node", http_api.Decorate(s.nodeHandler, s.inCluster, log, http_api.V1))
+	handle("GET", "/api/tombstones", http_api.Decorate(s.tombstonesHandler, s.inCluster, log, http_api.V1))
+	handle("POST", "/api/topics", http_api.Decorate(s.createTopicChannelHandler, s.inCluster, log, http_api.V1))
+	handle("POST", "/api/topics/:topic", http_api.Decorate(s.topicActionHandler, s.inCluster, log, http_api.V1))
+	handle("POST", "/api/topics/:topic/:channel", http_api.Decorate(s.channelActionHandler, s.inCluster, log, http_api.V1))
+	handle("POST", "/api/bulk", http_api.Decorate(s.bulkActionHandler, s.inCluster, log, http_api.V1))
+	handle("POST", "/api/nodes/:node", http_api.Decorate(s.nodeActionHandler, s.inCluster, log, http_api.V1))
+	handle("DELETE", "/api/nodes/:node", http_api.Decorate(s.tombstoneNodeForTopicHandler, s.inCluster, log, http_api.V1))
+	handle("DELETE", "/api/topics/:topic", http_api.Decorate(s.deleteTopicHandler, s.inCluster, log, http_api.V1))
+	handle("DELETE", "/api/topics/:topic/:channel", http_api.Decorate(s.deleteChannelHandler, s.inCluster, log, http_api.V1))
+	handle("GET", "/api/counter", http_api.Decorate(s.counterHandler, s.inCluster, log, http_api.V1))
+	handle("GET", "/api/alerts", http_api.Decorate(s.alertsHandler, s.inCluster, log, http_api.V1))
+	handle("POST", "/api/alerts/rules", http_api.Decorate(s.setAlertRuleHandler, s.inCluster, log, http_api.V1))
+	handle("DELETE", "/api/alerts/rules/:name", http_api.Decorate(s.deleteAlertRuleHandler, s.inCluster, log, http_api.V1))
+	handle("GET", "/api/audit", http_api.Decorate(s.auditHandler, s.inCluster, log, http_api.V1))
+	handle("GET", "/api/graphite", http_api.Decorate(s.graphiteHandler, s.inCluster, log, http_api.V1))
+	handle("GET", "/api/history", http_api.Decorate(s.historyHandler, s.inCluster, log, http_api.V1))
+	handle("GET", "/api/clusters", http_api.Decorate(s.clustersHandler, s.inCluster, log, http_api.V1))
 	router.Handle("GET", bp("/config/:opt"), http_api.Decorate(s.doConfig, log, http_api.V1))
+
+	if nsqadmin.oidc != nil {
+		router.Handle("GET", bp("/oidc/login"), http_api.Decorate(s.oidcLoginHandler, log))
+		router.Handle("GET", bp("/oidc/callback"), http_api.Decorate(s.oidcCallbackHandler, log))
+		router.Handle("GET", bp("/oidc/logout"), http_api.Decorate(s.oidcLogoutHandler, log))
+	}
 	router.Handle("PUT", bp("/config/:opt"), http_api.Decorate(s.doConfig, log, http_api.V1))
 
 	return s
 }
 
 func (s *httpServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if s.nsqadmin.oidc != nil && !s.requireLogin(w, req) {
+		return
+	}
 	s.router.ServeHTTP(w, req)
 }
 
@@ -130,6 +158,16 @@ func (s *httpServer) pingHandler(w http.ResponseWriter, req *http.Request, ps ht
 }
 
 func (s *httpServer) indexHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	c := s.nsqadmin.getCluster(ps.ByName("cluster"))
+	if c == nil {
+		http.Error(w, "CLUSTER_NOT_FOUND", 404)
+		return nil, nil
+	}
+	var clusters []string
+	for _, c := range s.nsqadmin.clusters {
+		clusters = append(clusters, c.name)
+	}
+
 	asset, _ := Asset("index.html")
 	t, _ := template.New("index").Funcs(template.FuncMap{
 		"basePath": func(p string) string {
@@ -137,6 +175,8 @@ func (s *httpServer) indexHandler(w http.ResponseWriter, req *http.Request, ps h
 		},
 	}).Parse(string(asset))
 
+	user, _ := s.requestIdentity(req)
+
 	w.Header().Set("Content-Type", "text/html")
 	t.Execute(w, struct {
 		Version             string
@@ -148,18 +188,28 @@ func (s *httpServer) indexHandler(w http.ResponseWriter, req *http.Request, ps h
 		StatsdGaugeFormat   string
 		StatsdPrefix        string
 		NSQLookupd          []string
+		Cluster             string
+		Clusters            []string
 		IsAdmin             bool
+		HistoryEnabled      bool
+		User                string
+		LoginEnabled        bool
 	}{
 		Version:             version.Binary,
 		ProxyGraphite:       s.nsqadmin.getOpts().ProxyGraphite,
-		GraphEnabled:        s.nsqadmin.getOpts().GraphiteURL != "",
+		GraphEnabled:        s.nsqadmin.getOpts().GraphiteURL != "" || c.history != nil,
 		GraphiteURL:         s.nsqadmin.getOpts().GraphiteURL,
 		StatsdInterval:      int(s.nsqadmin.getOpts().StatsdInterval / time.Second),
 		StatsdCounterFormat: s.nsqadmin.getOpts().StatsdCounterFormat,
 		StatsdGaugeFormat:   s.nsqadmin.getOpts().StatsdGaugeFormat,
 		StatsdPrefix:        s.nsqadmin.getOpts().StatsdPrefix,
-		NSQLookupd:          s.nsqadmin.getOpts().NSQLookupdHTTPAddresses,
-		IsAdmin:             s.isAuthorizedAdminRequest(req),
+		NSQLookupd:          c.lookupdHTTPAddrs(),
+		Cluster:             ps.ByName("cluster"),
+		Clusters:            clusters,
+		IsAdmin:             s.isAuthorized(req, roleAdmin, ""),
+		HistoryEnabled:      c.history != nil,
+		User:                user,
+		LoginEnabled:        s.nsqadmin.oidc != nil,
 	})
 
 	return nil, nil
@@ -209,6 +259,7 @@ func (s *httpServer) staticAssetHandler(w http.ResponseWriter, req *http.Request
 }
 
 func (s *httpServer) topicsHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	c := s.requestCluster(req)
 	var messages []string
 
 	reqParams, err := http_api.NewReqParams(req)
@@ -217,10 +268,10 @@ func (s *httpServer) topicsHandler(w http.ResponseWriter, req *http.Request, ps
 	}
 
 	var topics []string
-	if len(s.nsqadmin.getOpts().NSQLookupdHTTPAddresses) != 0 {
-		topics, err = s.ci.GetLookupdTopics(s.nsqadmin.getOpts().NSQLookupdHTTPAddresses)
+	if len(c.lookupdHTTPAddrs()) != 0 {
+		topics, err = c.ci.GetLookupdTopics(c.lookupdHTTPAddrs())
 	} else {
-		topics, err = s.ci.GetNSQDTopics(s.nsqadmin.getOpts().NSQDHTTPAddresses)
+		topics, err = c.ci.GetNSQDTopics(c.nsqdHTTPAddrs())
 	}
 	if err != nil {
 		pe, ok := err.(clusterinfo.PartialErr)
@@ -235,15 +286,13 @@ func (s *httpServer) topicsHandler(w http.ResponseWriter, req *http.Request, ps
 	inactive, _ := reqParams.Get("inactive")
 	if inactive == "true" {
 		topicChannelMap := make(map[string][]string)
-		if len(s.nsqadmin.getOpts().NSQLookupdHTTPAddresses) == 0 {
+		if len(c.lookupdHTTPAddrs()) == 0 {
 			goto respond
 		}
 		for _, topicName := range topics {
-			producers, _ := s.ci.GetLookupdTopicProducers(
-				topicName, s.nsqadmin.getOpts().NSQLookupdHTTPAddresses)
+			producers, _ := c.ci.GetLookupdTopicProducers(topicName, c.lookupdHTTPAddrs())
 			if len(producers) == 0 {
-				topicChannels, _ := s.ci.GetLookupdTopicChannels(
-					topicName, s.nsqadmin.getOpts().NSQLookupdHTTPAddresses)
+				topicChannels, _ := c.ci.GetLookupdTopicChannels(topicName, c.lookupdHTTPAddrs())
 				topicChannelMap[topicName] = topicChannels
 			}
 		}
@@ -261,13 +310,12 @@ func (s *httpServer) topicsHandler(w http.ResponseWriter, req *http.Request, ps
 }
 
 func (s *httpServer) topicHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	c := s.requestCluster(req)
 	var messages []string
 
 	topicName := ps.ByName("topic")
 
-	producers, err := s.ci.GetTopicProducers(topicName,
-		s.nsqadmin.getOpts().NSQLookupdHTTPAddresses,
-		s.nsqadmin.getOpts().NSQDHTTPAddresses)
+	producers, err := c.ci.GetTopicProducers(topicName, c.lookupdHTTPAddrs(), c.nsqdHTTPAddrs())
 	if err != nil {
 		pe, ok := err.(clusterinfo.PartialErr)
 		if !ok {
@@ -277,7 +325,7 @@ func (s *httpServer) topicHandler(w http.ResponseWriter, req *http.Request, ps h
 		s.nsqadmin.logf(LOG_WARN, "%s", err)
 		messages = append(messages, pe.Error())
 	}
-	topicStats, _, err := s.ci.GetNSQDStats(producers, topicName, "", false)
+	topicStats, _, err := c.ci.GetNSQDStats(producers, topicName, "", false)
 	if err != nil {
 		pe, ok := err.(clusterinfo.PartialErr)
 		if !ok {
@@ -293,21 +341,59 @@ func (s *httpServer) topicHandler(w http.ResponseWriter, req *http.Request, ps h
 		allNodesTopicStats.Add(t)
 	}
 
+	tombstones, err := s.getTombstones(c, topicName)
+	if err != nil {
+		messages = append(messages, err.Error())
+	}
+
+	metadata, err := s.getMetadata(c, topicName, "")
+	if err != nil {
+		messages = append(messages, err.Error())
+	}
+
 	return struct {
 		*clusterinfo.TopicStats
-		Message string `json:"message"`
-	}{allNodesTopicStats, maybeWarnMsg(messages)}, nil
+		Tombstones []*clusterinfo.Tombstone `json:"tombstones"`
+		Metadata   map[string]string        `json:"metadata"`
+		Role       string                   `json:"role"`
+		Message    string                   `json:"message"`
+	}{allNodesTopicStats, tombstones, metadata, s.requestRole(req, topicName).String(), maybeWarnMsg(messages)}, nil
+}
+
+// getMetadata returns the metadata nsqlookupd holds for a topic or channel,
+// errors are logged and returned as a warning
+func (s *httpServer) getMetadata(c *cluster, topicName string, channelName string) (map[string]string, error) {
+	if len(c.lookupdHTTPAddrs()) == 0 {
+		return nil, nil
+	}
+	metadata, err := c.ci.GetLookupdMetadata(topicName, channelName, c.lookupdHTTPAddrs())
+	if err != nil {
+		s.nsqadmin.logf(LOG_WARN, "failed to get metadata - %s", err)
+	}
+	return metadata, err
+}
+
+// getTombstones returns the tombstones set on nsqlookupd for topic, or every
+// topic when empty, errors are logged and returned as a warning
+func (s *httpServer) getTombstones(c *cluster, topicName string) ([]*clusterinfo.Tombstone, error) {
+	if len(c.lookupdHTTPAddrs()) == 0 {
+		return nil, nil
+	}
+	tombstones, err := c.ci.GetLookupdTombstones(topicName, c.lookupdHTTPAddrs())
+	if err != nil {
+		s.nsqadmin.logf(LOG_WARN, "failed to get tombstones - %s", err)
+	}
+	return tombstones, err
 }
 
 func (s *httpServer) channelHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	c := s.requestCluster(req)
 	var messages []string
 
 	topicName := ps.ByName("topic")
 	channelName := ps.ByName("channel")
 
-	producers, err := s.ci.GetTopicProducers(topicName,
-		s.nsqadmin.getOpts().NSQLookupdHTTPAddresses,
-		s.nsqadmin.getOpts().NSQDHTTPAddresses)
+	producers, err := c.ci.GetTopicProducers(topicName, c.lookupdHTTPAddrs(), c.nsqdHTTPAddrs())
 	if err != nil {
 		pe, ok := err.(clusterinfo.PartialErr)
 		if !ok {
@@ -317,7 +403,7 @@ func (s *httpServer) channelHandler(w http.ResponseWriter, req *http.Request, ps
 		s.nsqadmin.logf(LOG_WARN, "%s", err)
 		messages = append(messages, pe.Error())
 	}
-	_, channelStats, err := s.ci.GetNSQDStats(producers, topicName, channelName, true)
+	_, channelStats, err := c.ci.GetNSQDStats(producers, topicName, channelName, true)
 	if err != nil {
 		pe, ok := err.(clusterinfo.PartialErr)
 		if !ok {
@@ -328,16 +414,24 @@ func (s *httpServer) channelHandler(w http.ResponseWriter, req *http.Request, ps
 		messages = append(messages, pe.Error())
 	}
 
+	metadata, err := s.getMetadata(c, topicName, channelName)
+	if err != nil {
+		messages = append(messages, err.Error())
+	}
+
 	return struct {
 		*clusterinfo.ChannelStats
-		Message string `json:"message"`
-	}{channelStats[channelName], maybeWarnMsg(messages)}, nil
+		Metadata map[string]string `json:"metadata"`
+		Role     string            `json:"role"`
+		Message  string            `json:"message"`
+	}{channelStats[channelName], metadata, s.requestRole(req, topicName).String(), maybeWarnMsg(messages)}, nil
 }
 
 func (s *httpServer) nodesHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	c := s.requestCluster(req)
 	var messages []string
 
-	producers, err := s.ci.GetProducers(s.nsqadmin.getOpts().NSQLookupdHTTPAddresses, s.nsqadmin.getOpts().NSQDHTTPAddresses)
+	producers, err := c.ci.GetProducers(c.lookupdHTTPAddrs(), c.nsqdHTTPAddrs())
 	if err != nil {
 		pe, ok := err.(clusterinfo.PartialErr)
 		if !ok {
@@ -354,12 +448,41 @@ func (s *httpServer) nodesHandler(w http.ResponseWriter, req *http.Request, ps h
 	}{producers, maybeWarnMsg(messages)}, nil
 }
 
+func (s *httpServer) tombstonesHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	var messages []string
+
+	reqParams, err := http_api.NewReqParams(req)
+	if err != nil {
+		return nil, http_api.Err{400, err.Error()}
+	}
+
+	topicName, _ := reqParams.Get("topic")
+	if topicName != "" && !protocol.IsValidTopicName(topicName) {
+		return nil, http_api.Err{400, "INVALID_TOPIC"}
+	}
+
+	tombstones, err := s.getTombstones(s.requestCluster(req), topicName)
+	if err != nil {
+		pe, ok := err.(clusterinfo.PartialErr)
+		if !ok {
+			return nil, http_api.Err{502, fmt.Sprintf("UPSTREAM_ERROR: %s", err)}
+		}
+		messages = append(messages, pe.Error())
+	}
+
+	return struct {
+		Tombstones []*clusterinfo.Tombstone `json:"tombstones"`
+		Message    string                   `json:"message"`
+	}{tombstones, maybeWarnMsg(messages)}, nil
+}
+
 func (s *httpServer) nodeHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	c := s.requestCluster(req)
 	var messages []string
 
 	node := ps.ByName("node")
 
-	producers, err := s.ci.GetProducers(s.nsqadmin.getOpts().NSQLookupdHTTPAddresses, s.nsqadmin.getOpts().NSQDHTTPAddresses)
+	producers, err := c.ci.GetProducers(c.lookupdHTTPAddrs(), c.nsqdHTTPAddrs())
 	if err != nil {
 		pe, ok := err.(clusterinfo.PartialErr)
 		if !ok {
@@ -375,7 +498,7 @@ func (s *httpServer) nodeHandler(w http.ResponseWriter, req *http.Request, ps ht
 		return nil, http_api.Err{404, "NODE_NOT_FOUND"}
 	}
 
-	topicStats, _, err := s.ci.GetNSQDStats(clusterinfo.Producers{producer}, "", "", true)
+	topicStats, _, err := c.ci.GetNSQDStats(clusterinfo.Producers{producer}, "", "", true)
 	if err != nil {
 		s.nsqadmin.logf(LOG_ERROR, "failed to get nsqd stats - %s", err)
 		return nil, http_api.Err{502, fmt.Sprintf("UPSTREAM_ERROR: %s", err)}
@@ -390,22 +513,84 @@ func (s *httpServer) nodeHandler(w http.ResponseWriter, req *http.Request, ps ht
 		totalMessages += ts.MessageCount
 	}
 
+	allTombstones, err := s.getTombstones(c, "")
+	if err != nil {
+		messages = append(messages, err.Error())
+	}
+	var tombstones []*clusterinfo.Tombstone
+	for _, t := range allTombstones {
+		if t.Node == producer.HTTPAddress() {
+			tombstones = append(tombstones, t)
+		}
+	}
+
 	return struct {
 		Node          string                    `json:"node"`
 		TopicStats    []*clusterinfo.TopicStats `json:"topics"`
+		Tombstones    []*clusterinfo.Tombstone  `json:"tombstones"`
 		TotalMessages int64                     `json:"total_messages"`
 		TotalClients  int64                     `json:"total_clients"`
 		Message       string                    `json:"message"`
 	}{
 		Node:          node,
 		TopicStats:    topicStats,
+		Tombstones:    tombstones,
 		TotalMessages: totalMessages,
 		TotalClients:  totalClients,
 		Message:       maybeWarnMsg(messages),
 	}, nil
 }
 
+func (s *httpServer) nodeActionHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	c := s.requestCluster(req)
+	var messages []string
+
+	node := ps.ByName("node")
+
+	var body struct {
+		Action string `json:"action"`
+		Topic  string `json:"topic"`
+	}
+
+	err := json.NewDecoder(req.Body).Decode(&body)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_BODY"}
+	}
+
+	if !protocol.IsValidTopicName(body.Topic) {
+		return nil, http_api.Err{400, "INVALID_TOPIC"}
+	}
+
+	if !s.isAuthorized(req, roleAdmin, body.Topic) {
+		return nil, http_api.Err{403, "FORBIDDEN"}
+	}
+
+	switch body.Action {
+	case "untombstone":
+		err = c.ci.UntombstoneNodeForTopic(body.Topic, node, c.lookupdHTTPAddrs())
+
+		s.notifyAdminAction("untombstone_topic_producer", body.Topic, "", node, req)
+	default:
+		return nil, http_api.Err{400, "INVALID_ACTION"}
+	}
+
+	if err != nil {
+		pe, ok := err.(clusterinfo.PartialErr)
+		if !ok {
+			s.nsqadmin.logf(LOG_ERROR, "failed to %s node for topic - %s", body.Action, err)
+			return nil, http_api.Err{502, fmt.Sprintf("UPSTREAM_ERROR: %s", err)}
+		}
+		s.nsqadmin.logf(LOG_WARN, "%s", err)
+		messages = append(messages, pe.Error())
+	}
+
+	return struct {
+		Message string `json:"message"`
+	}{maybeWarnMsg(messages)}, nil
+}
+
 func (s *httpServer) tombstoneNodeForTopicHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	c := s.requestCluster(req)
 	var messages []string
 
 	node := ps.ByName("node")
@@ -422,8 +607,11 @@ func (s *httpServer) tombstoneNodeForTopicHandler(w http.ResponseWriter, req *ht
 		return nil, http_api.Err{400, "INVALID_TOPIC"}
 	}
 
-	err = s.ci.TombstoneNodeForTopic(body.Topic, node,
-		s.nsqadmin.getOpts().NSQLookupdHTTPAddresses)
+	if !s.isAuthorized(req, roleAdmin, body.Topic) {
+		return nil, http_api.Err{403, "FORBIDDEN"}
+	}
+
+	err = c.ci.TombstoneNodeForTopic(body.Topic, node, c.lookupdHTTPAddrs())
 	if err != nil {
 		pe, ok := err.(clusterinfo.PartialErr)
 		if !ok {
@@ -442,6 +630,7 @@ func (s *httpServer) tombstoneNodeForTopicHandler(w http.ResponseWriter, req *ht
 }
 
 func (s *httpServer) createTopicChannelHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	c := s.requestCluster(req)
 	var messages []string
 
 	var body struct {
@@ -449,10 +638,6 @@ func (s *httpServer) createTopicChannelHandler(w http.ResponseWriter, req *http.
 		Channel string `json:"channel"`
 	}
 
-	if !s.isAuthorizedAdminRequest(req) {
-		return nil, http_api.Err{403, "FORBIDDEN"}
-	}
-
 	err := json.NewDecoder(req.Body).Decode(&body)
 	if err != nil {
 		return nil, http_api.Err{400, err.Error()}
@@ -466,8 +651,11 @@ func (s *httpServer) createTopicChannelHandler(w http.ResponseWriter, req *http.
 		return nil, http_api.Err{400, "INVALID_CHANNEL"}
 	}
 
-	err = s.ci.CreateTopicChannel(body.Topic, body.Channel,
-		s.nsqadmin.getOpts().NSQLookupdHTTPAddresses)
+	if !s.isAuthorized(req, roleAdmin, body.Topic) {
+		return nil, http_api.Err{403, "FORBIDDEN"}
+	}
+
+	err = c.ci.CreateTopicChannel(body.Topic, body.Channel, c.lookupdHTTPAddrs())
 	if err != nil {
 		pe, ok := err.(clusterinfo.PartialErr)
 		if !ok {
@@ -489,17 +677,16 @@ func (s *httpServer) createTopicChannelHandler(w http.ResponseWriter, req *http.
 }
 
 func (s *httpServer) deleteTopicHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	c := s.requestCluster(req)
 	var messages []string
 
-	if !s.isAuthorizedAdminRequest(req) {
+	topicName := ps.ByName("topic")
+
+	if !s.isAuthorized(req, roleAdmin, topicName) {
 		return nil, http_api.Err{403, "FORBIDDEN"}
 	}
 
-	topicName := ps.ByName("topic")
-
-	err := s.ci.DeleteTopic(topicName,
-		s.nsqadmin.getOpts().NSQLookupdHTTPAddresses,
-		s.nsqadmin.getOpts().NSQDHTTPAddresses)
+	err := c.ci.DeleteTopic(topicName, c.lookupdHTTPAddrs(), c.nsqdHTTPAddrs())
 	if err != nil {
 		pe, ok := err.(clusterinfo.PartialErr)
 		if !ok {
@@ -518,18 +705,19 @@ func (s *httpServer) deleteTopicHandler(w http.ResponseWriter, req *http.Request
 }
 
 func (s *httpServer) deleteChannelHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	c := s.requestCluster(req)
 	var messages []string
 
-	if !s.isAuthorizedAdminRequest(req) {
-		return nil, http_api.Err{403, "FORBIDDEN"}
-	}
-
 	topicName := ps.ByName("topic")
 	channelName := ps.ByName("channel")
 
-	err := s.ci.DeleteChannel(topicName, channelName,
-		s.nsqadmin.getOpts().NSQLookupdHTTPAddresses,
-		s.nsqadmin.getOpts().NSQDHTTPAddresses)
+	if !s.isAuthorized(req, roleAdmin, topicName) {
+		return nil, http_api.Err{403, "FORBIDDEN"}
+	}
+
+	err := c.ci.DeleteChannel(topicName, channelName,
+		c.lookupdHTTPAddrs(),
+		c.nsqdHTTPAddrs())
 	if err != nil {
 		pe, ok := err.(clusterinfo.PartialErr)
 		if !ok {
@@ -547,26 +735,40 @@ func (s *httpServer) deleteChannelHandler(w http.ResponseWriter, req *http.Reque
 	}{maybeWarnMsg(messages)}, nil
 }
 
+// limits on the messages published through nsqadmin, the request body may be
+// twice as large to leave room for JSON escaping
+const (
+	maxPublishMessages = 1000
+	maxPublishSize     = 4 * 1024 * 1024
+)
+
 func (s *httpServer) topicActionHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
 	topicName := ps.ByName("topic")
+	req.Body = http.MaxBytesReader(w, req.Body, 2*maxPublishSize)
 	return s.topicChannelAction(req, topicName, "")
 }
 
 func (s *httpServer) channelActionHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
 	topicName := ps.ByName("topic")
 	channelName := ps.ByName("channel")
+	req.Body = http.MaxBytesReader(w, req.Body, 2*maxPublishSize)
 	return s.topicChannelAction(req, topicName, channelName)
 }
 
 func (s *httpServer) topicChannelAction(req *http.Request, topicName string, channelName string) (interface{}, error) {
+	c := s.requestCluster(req)
 	var messages []string
+	var node string
+	var published int
 
 	var body struct {
-		Action string `json:"action"`
-	}
-
-	if !s.isAuthorizedAdminRequest(req) {
-		return nil, http_api.Err{403, "FORBIDDEN"}
+		Action   string            `json:"action"`
+		Metadata map[string]string `json:"metadata"`
+		Messages []string          `json:"messages"`
+		Node     string            `json:"node"`
+		Defer    int64             `json:"defer"`
+		ClientID int64             `json:"client_id"`
+		Requeue  bool              `json:"requeue"`
 	}
 
 	err := json.NewDecoder(req.Body).Decode(&body)
@@ -574,49 +776,124 @@ func (s *httpServer) topicChannelAction(req *http.Request, topicName string, cha
 		return nil, http_api.Err{400, err.Error()}
 	}
 
+	// pausing, emptying and editing metadata are operator actions
+	if !s.isAuthorized(req, roleOperator, topicName) {
+		return nil, http_api.Err{403, "FORBIDDEN"}
+	}
+
 	switch body.Action {
 	case "pause":
 		if channelName != "" {
-			err = s.ci.PauseChannel(topicName, channelName,
-				s.nsqadmin.getOpts().NSQLookupdHTTPAddresses,
-				s.nsqadmin.getOpts().NSQDHTTPAddresses)
+			err = c.ci.PauseChannel(topicName, channelName,
+				c.lookupdHTTPAddrs(),
+				c.nsqdHTTPAddrs())
 
 			s.notifyAdminAction("pause_channel", topicName, channelName, "", req)
 		} else {
-			err = s.ci.PauseTopic(topicName,
-				s.nsqadmin.getOpts().NSQLookupdHTTPAddresses,
-				s.nsqadmin.getOpts().NSQDHTTPAddresses)
+			err = c.ci.PauseTopic(topicName, c.lookupdHTTPAddrs(), c.nsqdHTTPAddrs())
 
 			s.notifyAdminAction("pause_topic", topicName, "", "", req)
 		}
 	case "unpause":
 		if channelName != "" {
-			err = s.ci.UnPauseChannel(topicName, channelName,
-				s.nsqadmin.getOpts().NSQLookupdHTTPAddresses,
-				s.nsqadmin.getOpts().NSQDHTTPAddresses)
+			err = c.ci.UnPauseChannel(topicName, channelName,
+				c.lookupdHTTPAddrs(),
+				c.nsqdHTTPAddrs())
 
 			s.notifyAdminAction("unpause_channel", topicName, channelName, "", req)
 		} else {
-			err = s.ci.UnPauseTopic(topicName,
-				s.nsqadmin.getOpts().NSQLookupdHTTPAddresses,
-				s.nsqadmin.getOpts().NSQDHTTPAddresses)
+			err = c.ci.UnPauseTopic(topicName, c.lookupdHTTPAddrs(), c.nsqdHTTPAddrs())
 
 			s.notifyAdminAction("unpause_topic", topicName, "", "", req)
 		}
 	case "empty":
 		if channelName != "" {
-			err = s.ci.EmptyChannel(topicName, channelName,
-				s.nsqadmin.getOpts().NSQLookupdHTTPAddresses,
-				s.nsqadmin.getOpts().NSQDHTTPAddresses)
+			err = c.ci.EmptyChannel(topicName, channelName,
+				c.lookupdHTTPAddrs(),
+				c.nsqdHTTPAddrs())
 
 			s.notifyAdminAction("empty_channel", topicName, channelName, "", req)
 		} else {
-			err = s.ci.EmptyTopic(topicName,
-				s.nsqadmin.getOpts().NSQLookupdHTTPAddresses,
-				s.nsqadmin.getOpts().NSQDHTTPAddresses)
+			err = c.ci.EmptyTopic(topicName, c.lookupdHTTPAddrs(), c.nsqdHTTPAddrs())
 
 			s.notifyAdminAction("empty_topic", topicName, "", "", req)
 		}
+	case "set_metadata":
+		err = c.ci.SetLookupdMetadata(topicName, channelName, body.Metadata, c.lookupdHTTPAddrs())
+
+		if channelName != "" {
+			s.notifyAdminAction("set_channel_metadata", topicName, channelName, "", req)
+		} else {
+			s.notifyAdminAction("set_topic_metadata", topicName, "", "", req)
+		}
+	case "publish":
+		// publishing is an admin action, defer is in milliseconds like for
+		// nsqd /pub
+		if !s.isAuthorized(req, roleAdmin, topicName) {
+			return nil, http_api.Err{403, "FORBIDDEN"}
+		}
+		if channelName != "" {
+			return nil, http_api.Err{400, "INVALID_ACTION"}
+		}
+		if len(body.Messages) == 0 {
+			return nil, http_api.Err{400, "MISSING_MESSAGES"}
+		}
+		if len(body.Messages) > maxPublishMessages {
+			return nil, http_api.Err{400, "TOO_MANY_MESSAGES"}
+		}
+		msgs := make([][]byte, 0, len(body.Messages))
+		var size int
+		for _, m := range body.Messages {
+			if m == "" {
+				return nil, http_api.Err{400, "INVALID_MESSAGE"}
+			}
+			size += len(m)
+			msgs = append(msgs, []byte(m))
+		}
+		if size > maxPublishSize {
+			return nil, http_api.Err{413, "MESSAGES_TOO_BIG"}
+		}
+		if body.Defer < 0 {
+			return nil, http_api.Err{400, "INVALID_DEFER"}
+		}
+
+		node, published, err = c.ci.PublishMessages(topicName, body.Node, msgs,
+			time.Duration(body.Defer)*time.Millisecond,
+			c.lookupdHTTPAddrs(),
+			c.nsqdHTTPAddrs())
+		switch err {
+		case clusterinfo.ErrUnknownNode:
+			return nil, http_api.Err{400, "INVALID_NODE"}
+		case clusterinfo.ErrNoTopicProducers:
+			return nil, http_api.Err{404, "TOPIC_NOT_FOUND"}
+		}
+
+		// a failed publish is only an admin action for the messages which
+		// made it before the failure
+		if published > 0 {
+			s.notifyAdminAction("publish", topicName, "", node, req)
+		}
+		if err != nil && published > 0 {
+			err = fmt.Errorf("published %d of %d messages - %s", published, len(msgs), err)
+		}
+	case "disconnect":
+		// disconnecting a client is an admin action
+		if !s.isAuthorized(req, roleAdmin, topicName) {
+			return nil, http_api.Err{403, "FORBIDDEN"}
+		}
+		if channelName == "" {
+			return nil, http_api.Err{400, "INVALID_ACTION"}
+		}
+
+		node = body.Node
+		err = c.ci.DisconnectClient(topicName, channelName, node, body.ClientID, body.Requeue,
+			c.lookupdHTTPAddrs(),
+			c.nsqdHTTPAddrs())
+		if err == clusterinfo.ErrUnknownNode {
+			return nil, http_api.Err{400, "INVALID_NODE"}
+		}
+
+		s.notifyAdminAction("disconnect_client", topicName, channelName, node, req)
 	default:
 		return nil, http_api.Err{400, "INVALID_ACTION"}
 	}
@@ -632,8 +909,70 @@ func (s *httpServer) topicChannelAction(req *http.Request, topicName string, cha
 	}
 
 	return struct {
-		Message string `json:"message"`
-	}{maybeWarnMsg(messages)}, nil
+		Message   string `json:"message"`
+		Node      string `json:"node,omitempty"`
+		Published int    `json:"published,omitempty"`
+	}{maybeWarnMsg(messages), node, published}, nil
+}
+
+// bulkActionHandler applies an action to every topic or channel matching the
+// request, or with dry_run only lists them, and returns a result for each
+func (s *httpServer) bulkActionHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	var messages []string
+
+	var body bulkRequest
+	err := json.NewDecoder(req.Body).Decode(&body)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_BODY"}
+	}
+
+	required, err := body.validate()
+	if err != nil {
+		return nil, http_api.Err{400, fmt.Sprintf("INVALID_REQUEST: %s", err)}
+	}
+
+	c := s.requestCluster(req)
+	results, err := s.bulkTargets(c, &body)
+	if err != nil {
+		pe, ok := err.(clusterinfo.PartialErr)
+		if !ok {
+			s.nsqadmin.logf(LOG_ERROR, "failed to match topics/channels - %s", err)
+			return nil, http_api.Err{502, fmt.Sprintf("UPSTREAM_ERROR: %s", err)}
+		}
+		s.nsqadmin.logf(LOG_WARN, "%s", err)
+		messages = append(messages, pe.Error())
+	}
+
+	for i := range results {
+		r := &results[i]
+		if !s.isAuthorized(req, required, r.Topic) {
+			r.Status = bulkForbidden
+			continue
+		}
+		if body.DryRun {
+			r.Status = bulkMatched
+			continue
+		}
+
+		r.Status = bulkOK
+		err := s.bulkApply(c, body.Action, r.Topic, r.Channel, req)
+		if err != nil {
+			if _, ok := err.(clusterinfo.PartialErr); ok {
+				r.Status = bulkWarning
+			} else {
+				r.Status = bulkError
+			}
+			r.Message = err.Error()
+			s.nsqadmin.logf(LOG_WARN, "failed to %s %s/%s - %s", body.Action, r.Topic, r.Channel, err)
+		}
+	}
+
+	return struct {
+		Action  string       `json:"action"`
+		DryRun  bool         `json:"dry_run"`
+		Results []bulkResult `json:"results"`
+		Message string       `json:"message"`
+	}{body.Action, body.DryRun, results, maybeWarnMsg(messages)}, nil
 }
 
 type counterStats struct {
@@ -644,10 +983,11 @@ type counterStats struct {
 }
 
 func (s *httpServer) counterHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	c := s.requestCluster(req)
 	var messages []string
 	stats := make(map[string]*counterStats)
 
-	producers, err := s.ci.GetProducers(s.nsqadmin.getOpts().NSQLookupdHTTPAddresses, s.nsqadmin.getOpts().NSQDHTTPAddresses)
+	producers, err := c.ci.GetProducers(c.lookupdHTTPAddrs(), c.nsqdHTTPAddrs())
 	if err != nil {
 		pe, ok := err.(clusterinfo.PartialErr)
 		if !ok {
@@ -657,7 +997,7 @@ func (s *httpServer) counterHandler(w http.ResponseWriter, req *http.Request, ps
 		s.nsqadmin.logf(LOG_WARN, "%s", err)
 		messages = append(messages, pe.Error())
 	}
-	_, channelStats, err := s.ci.GetNSQDStats(producers, "", "", false)
+	_, channelStats, err := c.ci.GetNSQDStats(producers, "", "", false)
 	if err != nil {
 		pe, ok := err.(clusterinfo.PartialErr)
 		if !ok {
@@ -690,6 +1030,104 @@ func (s *httpServer) counterHandler(w http.ResponseWriter, req *http.Request, ps
 	}{stats, maybeWarnMsg(messages)}, nil
 }
 
+func (s *httpServer) alertsHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	return struct {
+		Alerts []Alert      `json:"alerts"`
+		Rules  []*AlertRule `json:"rules"`
+	}{s.nsqadmin.alerts.activeAlerts(s.requestCluster(req).name), s.nsqadmin.alerts.getRules()}, nil
+}
+
+func (s *httpServer) setAlertRuleHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	if !s.isAuthorized(req, roleAdmin, "") {
+		return nil, http_api.Err{403, "FORBIDDEN"}
+	}
+
+	var rule AlertRule
+	err := json.NewDecoder(req.Body).Decode(&rule)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_BODY"}
+	}
+
+	err = rule.validate()
+	if err != nil {
+		return nil, http_api.Err{400, fmt.Sprintf("INVALID_RULE: %s", err)}
+	}
+
+	err = s.nsqadmin.alerts.setRule(&rule)
+	if err != nil {
+		s.nsqadmin.logf(LOG_ERROR, "failed to persist alert rules - %s", err)
+		return nil, http_api.Err{500, "INTERNAL_ERROR"}
+	}
+
+	s.notifyAdminAction("set_alert_rule", rule.Topic, rule.Channel, "", req)
+
+	return nil, nil
+}
+
+func (s *httpServer) deleteAlertRuleHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	if !s.isAuthorized(req, roleAdmin, "") {
+		return nil, http_api.Err{403, "FORBIDDEN"}
+	}
+
+	name := ps.ByName("name")
+	ok, err := s.nsqadmin.alerts.deleteRule(name)
+	if err != nil {
+		s.nsqadmin.logf(LOG_ERROR, "failed to persist alert rules - %s", err)
+		return nil, http_api.Err{500, "INTERNAL_ERROR"}
+	}
+	if !ok {
+		return nil, http_api.Err{404, "RULE_NOT_FOUND"}
+	}
+
+	s.notifyAdminAction("delete_alert_rule", "", "", "", req)
+
+	return nil, nil
+}
+
+// auditHandler returns the recorded admin actions on a cluster, newest first,
+// on the topics the user is at least an operator of
+func (s *httpServer) auditHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	reqParams, err := http_api.NewReqParams(req)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_REQUEST"}
+	}
+
+	filter := &auditFilter{Cluster: s.requestCluster(req).name, Limit: 100}
+	filter.User, _ = reqParams.Get("user")
+	filter.Topic, _ = reqParams.Get("topic")
+	for _, p := range []struct {
+		name string
+		v    *int64
+	}{{"since", &filter.Since}, {"until", &filter.Until}} {
+		value, _ := reqParams.Get(p.name)
+		if value == "" {
+			continue
+		}
+		*p.v, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, http_api.Err{400, "INVALID_" + strings.ToUpper(p.name)}
+		}
+	}
+	if limit, _ := reqParams.Get("limit"); limit != "" {
+		filter.Limit, err = strconv.Atoi(limit)
+		if err != nil || filter.Limit < 1 {
+			return nil, http_api.Err{400, "INVALID_LIMIT"}
+		}
+	}
+
+	actions, err := s.nsqadmin.audit.query(filter, func(a *AdminAction) bool {
+		return s.isAuthorized(req, roleOperator, a.Topic)
+	})
+	if err != nil {
+		s.nsqadmin.logf(LOG_ERROR, "failed to read audit log - %s", err)
+		return nil, http_api.Err{500, "INTERNAL_ERROR"}
+	}
+
+	return struct {
+		Actions []*AdminAction `json:"actions"`
+	}{actions}, nil
+}
+
 func (s *httpServer) graphiteHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
 	reqParams, err := http_api.NewReqParams(req)
 	if err != nil {
@@ -739,6 +1177,121 @@ func (s *httpServer) graphiteHandler(w http.ResponseWriter, req *http.Request, p
 	}{rateStr}, nil
 }
 
+// historyHandler serves the sampled series of a topic, channel or node as
+// JSON or, with format=svg and a metric, as a line graph
+func (s *httpServer) historyHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	c := s.requestCluster(req)
+	if c.history == nil {
+		return nil, http_api.Err{404, "HISTORY_DISABLED"}
+	}
+
+	reqParams, err := http_api.NewReqParams(req)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_REQUEST"}
+	}
+
+	var k seriesKey
+	k.typ, _ = reqParams.Get("type")
+	k.node, _ = reqParams.Get("node")
+	k.topic, _ = reqParams.Get("topic")
+	k.channel, _ = reqParams.Get("channel")
+	k.metric, _ = reqParams.Get("metric")
+	if k.node == "" {
+		k.node = "*"
+	}
+	switch k.typ {
+	case historyNode:
+		k.topic, k.channel = "", ""
+	case historyTopic:
+		k.channel = ""
+		if k.topic == "" {
+			return nil, http_api.Err{400, "MISSING_ARG_TOPIC"}
+		}
+	case historyChannel:
+		if k.topic == "" {
+			return nil, http_api.Err{400, "MISSING_ARG_TOPIC"}
+		}
+		if k.channel == "" {
+			return nil, http_api.Err{400, "MISSING_ARG_CHANNEL"}
+		}
+	default:
+		return nil, http_api.Err{400, "INVALID_ARG_TYPE"}
+	}
+
+	var since time.Time
+	if from, _ := reqParams.Get("from"); from != "" {
+		d, err := time.ParseDuration(from)
+		if err != nil || d <= 0 {
+			return nil, http_api.Err{400, "INVALID_ARG_FROM"}
+		}
+		since = time.Now().Add(-d)
+	}
+
+	if format, _ := reqParams.Get("format"); format == "svg" {
+		if k.metric == "" {
+			return nil, http_api.Err{400, "MISSING_ARG_METRIC"}
+		}
+		size := func(name string, def int) (int, error) {
+			v, _ := reqParams.Get(name)
+			if v == "" {
+				return def, nil
+			}
+			i, err := strconv.Atoi(v)
+			if err != nil || i < 1 || i > 4096 {
+				return 0, http_api.Err{400, "INVALID_ARG_" + strings.ToUpper(name)}
+			}
+			return i, nil
+		}
+		width, err := size("width", 120)
+		if err != nil {
+			return nil, err
+		}
+		height, err := size("height", 20)
+		if err != nil {
+			return nil, err
+		}
+		color := "blue"
+		if c, _ := reqParams.Get("color"); c == "red" || c == "green" {
+			color = c
+		}
+		w.Header().Set("Content-Type", "image/svg+xml")
+		return renderSVG(c.history.get(k, since), width, height, color), nil
+	}
+
+	metrics := []string{k.metric}
+	if k.metric == "" {
+		metrics = c.history.metrics(k)
+	}
+	series := make(map[string][][2]float64)
+	for _, metric := range metrics {
+		k.metric = metric
+		series[metric] = c.history.get(k, since)
+	}
+	return struct {
+		Interval int64                   `json:"interval"`
+		Series   map[string][][2]float64 `json:"series"`
+	}{int64(s.nsqadmin.getOpts().HistoryInterval / time.Second), series}, nil
+}
+
+// clustersHandler returns a summary of every cluster, the first one is the
+// default
+func (s *httpServer) clustersHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	summaries := make([]*clusterSummary, len(s.nsqadmin.clusters))
+	var wg sync.WaitGroup
+	for i, c := range s.nsqadmin.clusters {
+		wg.Add(1)
+		go func(i int, c *cluster) {
+			defer wg.Done()
+			summaries[i] = c.summary()
+		}(i, c)
+	}
+	wg.Wait()
+
+	return struct {
+		Clusters []*clusterSummary `json:"clusters"`
+	}{summaries}, nil
+}
+
 func (s *httpServer) doConfig(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
 	opt := ps.ByName("opt")
 
@@ -800,21 +1353,6 @@ func (s *httpServer) doConfig(w http.ResponseWriter, req *http.Request, ps httpr
 	return v, nil
 }
 
-func (s *httpServer) isAuthorizedAdminRequest(req *http.Request) bool {
-	adminUsers := s.nsqadmin.getOpts().AdminUsers
-	if len(adminUsers) == 0 {
-		return true
-	}
-	aclHttpHeader := s.nsqadmin.getOpts().AclHttpHeader
-	user := req.Header.Get(aclHttpHeader)
-	for _, v := range adminUsers {
-		if v == user {
-			return true
-		}
-	}
-	return false
-}
-
 func getOptByCfgName(opts interface{}, name string) (interface{}, bool) {
 	val := reflect.ValueOf(opts).Elem()
 	typ := val.Type()
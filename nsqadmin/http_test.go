@@ -2,13 +2,24 @@ package nsqadmin
 
 import (
 	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"net"
 	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -391,6 +402,74 @@ func TestHTTPTombstoneTopicNodePOST(t *testing.T) {
 	resp.Body.Close()
 }
 
+func TestHTTPUntombstoneTopicNodePOST(t *testing.T) {
+	dataPath, nsqds, nsqlookupds, nsqadmin1 := bootstrapNSQCluster(t)
+	defer os.RemoveAll(dataPath)
+	defer nsqds[0].Exit()
+	defer nsqlookupds[0].Exit()
+	defer nsqadmin1.Exit()
+
+	topicName := "test_untombstone_topic_node_post" + strconv.Itoa(int(time.Now().Unix()))
+	nsqds[0].GetTopic(topicName)
+	time.Sleep(100 * time.Millisecond)
+
+	// tombstone on nsqlookupd only, so the nsqd stays registered for the topic
+	client := http.Client{}
+	url := fmt.Sprintf("http://%s/topic/tombstone?topic=%s&node=%s",
+		nsqlookupds[0].RealHTTPAddr(), topicName, nsqds[0].RealHTTPAddr())
+	resp, err := client.Post(url, "application/json", nil)
+	test.Nil(t, err)
+	test.Equal(t, 200, resp.StatusCode)
+	resp.Body.Close()
+
+	type tombstonesDoc struct {
+		Tombstones []struct {
+			Topic             string `json:"topic"`
+			Node              string `json:"node"`
+			RemainingLifetime int64  `json:"remaining_lifetime"`
+		} `json:"tombstones"`
+	}
+
+	url = fmt.Sprintf("http://%s/api/tombstones?topic=%s", nsqadmin1.RealHTTPAddr(), topicName)
+	resp, err = client.Get(url)
+	test.Nil(t, err)
+	test.Equal(t, 200, resp.StatusCode)
+	var td tombstonesDoc
+	err = json.NewDecoder(resp.Body).Decode(&td)
+	resp.Body.Close()
+	test.Nil(t, err)
+	test.Equal(t, 1, len(td.Tombstones))
+	test.Equal(t, nsqds[0].RealHTTPAddr().String(), td.Tombstones[0].Node)
+
+	url = fmt.Sprintf("http://%s/api/nodes/%s", nsqadmin1.RealHTTPAddr(), nsqds[0].RealHTTPAddr())
+	resp, err = client.Get(url)
+	test.Nil(t, err)
+	test.Equal(t, 200, resp.StatusCode)
+	td = tombstonesDoc{}
+	err = json.NewDecoder(resp.Body).Decode(&td)
+	resp.Body.Close()
+	test.Nil(t, err)
+	test.Equal(t, 1, len(td.Tombstones))
+	test.Equal(t, topicName, td.Tombstones[0].Topic)
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"action": "untombstone",
+		"topic":  topicName,
+	})
+	resp, err = client.Post(url, "application/json", bytes.NewBuffer(body))
+	test.Nil(t, err)
+	test.Equal(t, 200, resp.StatusCode)
+	resp.Body.Close()
+
+	resp, err = client.Get(url)
+	test.Nil(t, err)
+	td = tombstonesDoc{}
+	err = json.NewDecoder(resp.Body).Decode(&td)
+	resp.Body.Close()
+	test.Nil(t, err)
+	test.Equal(t, 0, len(td.Tombstones))
+}
+
 func TestHTTPDeleteTopicPOST(t *testing.T) {
 	dataPath, nsqds, nsqlookupds, nsqadmin1 := bootstrapNSQCluster(t)
 	defer os.RemoveAll(dataPath)
@@ -501,6 +580,526 @@ func TestHTTPPauseChannelPOST(t *testing.T) {
 	resp.Body.Close()
 }
 
+func TestHTTPTopicMetadataPOST(t *testing.T) {
+	dataPath, nsqds, nsqlookupds, nsqadmin1 := bootstrapNSQCluster(t)
+	defer os.RemoveAll(dataPath)
+	defer nsqds[0].Exit()
+	defer nsqlookupds[0].Exit()
+	defer nsqadmin1.Exit()
+
+	topicName := "test_topic_metadata_post" + strconv.Itoa(int(time.Now().Unix()))
+	nsqds[0].GetTopic(topicName)
+	time.Sleep(100 * time.Millisecond)
+
+	client := http.Client{}
+	url := fmt.Sprintf("http://%s/api/topics/%s", nsqadmin1.RealHTTPAddr(), topicName)
+	body, _ := json.Marshal(map[string]interface{}{
+		"action":   "set_metadata",
+		"metadata": map[string]string{"owner": "team-a"},
+	})
+	req, _ := http.NewRequest("POST", url, bytes.NewBuffer(body))
+	resp, err := client.Do(req)
+	test.Nil(t, err)
+	test.Equal(t, 200, resp.StatusCode)
+	resp.Body.Close()
+
+	resp, err = client.Get(url)
+	test.Nil(t, err)
+	test.Equal(t, 200, resp.StatusCode)
+	var td struct {
+		Metadata map[string]string `json:"metadata"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&td)
+	resp.Body.Close()
+	test.Nil(t, err)
+	test.Equal(t, map[string]string{"owner": "team-a"}, td.Metadata)
+}
+
+func TestHTTPAlertRules(t *testing.T) {
+	dataPath, nsqds, nsqlookupds, nsqadmin1 := bootstrapNSQCluster(t)
+	defer os.RemoveAll(dataPath)
+	defer nsqds[0].Exit()
+	defer nsqlookupds[0].Exit()
+	defer nsqadmin1.Exit()
+
+	client := http.Client{}
+	url := fmt.Sprintf("http://%s/api/alerts/rules", nsqadmin1.RealHTTPAddr())
+
+	body, _ := json.Marshal(AlertRule{Name: "backlog", Type: "queue_size"})
+	resp, err := client.Post(url, "application/json", bytes.NewBuffer(body))
+	test.Nil(t, err)
+	test.Equal(t, 400, resp.StatusCode)
+	resp.Body.Close()
+
+	body, _ = json.Marshal(AlertRule{Name: "backlog", Type: alertDepth, Topic: "orders*", Threshold: 100})
+	resp, err = client.Post(url, "application/json", bytes.NewBuffer(body))
+	test.Nil(t, err)
+	test.Equal(t, 200, resp.StatusCode)
+	resp.Body.Close()
+
+	resp, err = client.Get(fmt.Sprintf("http://%s/api/alerts", nsqadmin1.RealHTTPAddr()))
+	test.Nil(t, err)
+	test.Equal(t, 200, resp.StatusCode)
+	var ad struct {
+		Alerts []Alert     `json:"alerts"`
+		Rules  []AlertRule `json:"rules"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&ad)
+	resp.Body.Close()
+	test.Nil(t, err)
+	test.Equal(t, 1, len(ad.Rules))
+	test.Equal(t, "orders*", ad.Rules[0].Topic)
+
+	req, _ := http.NewRequest("DELETE", url+"/backlog", nil)
+	resp, err = client.Do(req)
+	test.Nil(t, err)
+	test.Equal(t, 200, resp.StatusCode)
+	resp.Body.Close()
+
+	resp, err = client.Do(req)
+	test.Nil(t, err)
+	test.Equal(t, 404, resp.StatusCode)
+	resp.Body.Close()
+}
+
+func TestHTTPHistory(t *testing.T) {
+	dataPath, nsqds, nsqlookupds, nsqadmin1 := bootstrapNSQCluster(t)
+	defer os.RemoveAll(dataPath)
+	defer nsqds[0].Exit()
+	defer nsqlookupds[0].Exit()
+	defer nsqadmin1.Exit()
+
+	now := time.Now()
+	for i, depth := range []int64{3, 4} {
+		nsqadmin1.clusters[0].history.record(newHistorySample([]*clusterinfo.TopicStats{
+			{
+				Node:      "127.0.0.1:4151",
+				TopicName: "history_topic",
+				Depth:     depth,
+				Channels: []*clusterinfo.ChannelStats{
+					{ChannelName: "ch", Depth: depth * 2, ClientCount: 1},
+				},
+			},
+		}), now.Add(time.Duration(i-2)*time.Minute))
+	}
+
+	client := http.Client{}
+	endpoint := fmt.Sprintf("http://%s/api/history", nsqadmin1.RealHTTPAddr())
+
+	resp, err := client.Get(endpoint + "?type=channel&topic=history_topic&channel=ch")
+	test.Nil(t, err)
+	test.Equal(t, 200, resp.StatusCode)
+	var hd struct {
+		Interval int64                   `json:"interval"`
+		Series   map[string][][2]float64 `json:"series"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&hd)
+	resp.Body.Close()
+	test.Nil(t, err)
+	test.Equal(t, int64(60), hd.Interval)
+	test.Equal(t, 2, len(hd.Series["depth"]))
+	test.Equal(t, float64(8), hd.Series["depth"][1][1])
+	test.Equal(t, 2, len(hd.Series["clients"]))
+	test.Equal(t, 1, len(hd.Series["message_count"]))
+
+	resp, err = client.Get(endpoint + "?type=node&node=127.0.0.1:4151&metric=depth&from=90s")
+	test.Nil(t, err)
+	test.Equal(t, 200, resp.StatusCode)
+	hd.Series = nil
+	err = json.NewDecoder(resp.Body).Decode(&hd)
+	resp.Body.Close()
+	test.Nil(t, err)
+	test.Equal(t, 1, len(hd.Series["depth"]))
+	test.Equal(t, float64(12), hd.Series["depth"][0][1])
+
+	resp, err = client.Get(endpoint + "?type=topic&topic=history_topic&metric=depth&format=svg")
+	test.Nil(t, err)
+	test.Equal(t, 200, resp.StatusCode)
+	test.Equal(t, "image/svg+xml", resp.Header.Get("Content-Type"))
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	test.Equal(t, true, strings.Contains(string(body), "<polyline"))
+
+	resp, err = client.Get(endpoint + "?type=channel&topic=history_topic")
+	test.Nil(t, err)
+	test.Equal(t, 400, resp.StatusCode)
+	resp.Body.Close()
+}
+
+func TestHTTPRoleBindings(t *testing.T) {
+	dataPath, nsqds, nsqlookupds, nsqadmin1 := bootstrapNSQCluster(t)
+	defer os.RemoveAll(dataPath)
+	defer nsqds[0].Exit()
+	defer nsqlookupds[0].Exit()
+	defer nsqadmin1.Exit()
+
+	opts := NewOptions()
+	opts.HTTPAddress = "127.0.0.1:0"
+	opts.NSQLookupdHTTPAddresses = []string{nsqlookupds[0].RealHTTPAddr().String()}
+	opts.Logger = test.NewTestLogger(t)
+	opts.RoleBindings = []string{"operator:group:orders-team:orders_*"}
+	opts.AclGroupsHttpHeader = "X-Forwarded-Groups"
+	nsqadmin2, err := New(opts)
+	test.Nil(t, err)
+	go func() {
+		err := nsqadmin2.Main()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer nsqadmin2.Exit()
+
+	for _, topicName := range []string{"orders_rbac", "payments_rbac"} {
+		nsqds[0].GetTopic(topicName).GetChannel("ch")
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	do := func(method string, path string, body string, groups string) *http.Response {
+		url := fmt.Sprintf("http://%s%s", nsqadmin2.RealHTTPAddr(), path)
+		req, _ := http.NewRequest(method, url, bytes.NewBufferString(body))
+		req.Header.Set("X-Forwarded-User", "alice")
+		req.Header.Set("X-Forwarded-Groups", groups)
+		resp, err := http.DefaultClient.Do(req)
+		test.Nil(t, err)
+		resp.Body.Close()
+		return resp
+	}
+
+	pause := `{"action":"pause"}`
+	test.Equal(t, 403, do("POST", "/api/topics/orders_rbac", pause, "").StatusCode)
+	test.Equal(t, 200, do("POST", "/api/topics/orders_rbac", pause, "dev, orders-team").StatusCode)
+	test.Equal(t, 200, do("POST", "/api/topics/orders_rbac/ch", pause, "orders-team").StatusCode)
+	test.Equal(t, 403, do("POST", "/api/topics/payments_rbac", pause, "orders-team").StatusCode)
+	test.Equal(t, 403, do("DELETE", "/api/topics/orders_rbac", "", "orders-team").StatusCode)
+	test.Equal(t, 403, do("DELETE", "/api/nodes/127.0.0.1:4151", `{"topic":"orders_rbac"}`, "orders-team").StatusCode)
+
+	url := fmt.Sprintf("http://%s/api/topics/orders_rbac", nsqadmin2.RealHTTPAddr())
+	req, _ := http.NewRequest("GET", url, nil)
+	req.Header.Set("X-Forwarded-Groups", "orders-team")
+	resp, err := http.DefaultClient.Do(req)
+	test.Nil(t, err)
+	var td struct {
+		Role   string `json:"role"`
+		Paused bool   `json:"paused"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&td)
+	resp.Body.Close()
+	test.Nil(t, err)
+	test.Equal(t, "operator", td.Role)
+	test.Equal(t, true, td.Paused)
+}
+
+// fakeIssuer is an OpenID Connect issuer which logs in every user as alice,
+// a member of nsq-admins
+type fakeIssuer struct {
+	*httptest.Server
+	key   *rsa.PrivateKey
+	nonce string
+}
+
+func newFakeIssuer(t *testing.T, clientID string) *fakeIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.Nil(t, err)
+	f := &fakeIssuer{key: key}
+	b64 := base64.RawURLEncoding.EncodeToString
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, req *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 f.URL,
+			"authorization_endpoint": f.URL + "/authorize",
+			"token_endpoint":         f.URL + "/token",
+			"jwks_uri":               f.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, req *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kid": "k1",
+				"kty": "RSA",
+				"n":   b64(key.N.Bytes()),
+				"e":   b64(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, req *http.Request) {
+		user, _, _ := req.BasicAuth()
+		if user != clientID || req.FormValue("code") != "the-code" {
+			w.WriteHeader(400)
+			return
+		}
+		header, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": "k1"})
+		claims, _ := json.Marshal(map[string]interface{}{
+			"iss":    f.URL,
+			"aud":    clientID,
+			"sub":    "1234",
+			"email":  "alice@example.com",
+			"groups": []string{"nsq-admins"},
+			"exp":    time.Now().Add(time.Hour).Unix(),
+			"nonce":  f.nonce,
+		})
+		signed := b64(header) + "." + b64(claims)
+		digest := sha256.Sum256([]byte(signed))
+		signature, _ := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		json.NewEncoder(w).Encode(map[string]string{"id_token": signed + "." + b64(signature)})
+	})
+	f.Server = httptest.NewServer(mux)
+	return f
+}
+
+func TestHTTPOIDCLogin(t *testing.T) {
+	dataPath, nsqds, nsqlookupds, nsqadmin1 := bootstrapNSQCluster(t)
+	defer os.RemoveAll(dataPath)
+	defer nsqds[0].Exit()
+	defer nsqlookupds[0].Exit()
+	defer nsqadmin1.Exit()
+
+	issuer := newFakeIssuer(t, "nsqadmin")
+	defer issuer.Close()
+
+	actions := make(chan *AdminAction, 1)
+	notifications := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var a AdminAction
+		json.NewDecoder(req.Body).Decode(&a)
+		actions <- &a
+	}))
+	defer notifications.Close()
+
+	opts := NewOptions()
+	opts.HTTPAddress = "127.0.0.1:0"
+	opts.NSQLookupdHTTPAddresses = []string{nsqlookupds[0].RealHTTPAddr().String()}
+	opts.Logger = test.NewTestLogger(t)
+	opts.NotificationHTTPEndpoint = notifications.URL
+	opts.RoleBindings = []string{"admin:group:nsq-admins"}
+	opts.OIDCIssuerURL = issuer.URL
+	opts.OIDCClientID = "nsqadmin"
+	opts.OIDCRedirectURL = "http://nsqadmin.example.com/oidc/callback"
+	nsqadmin2, err := New(opts)
+	test.Nil(t, err)
+	go func() {
+		err := nsqadmin2.Main()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer nsqadmin2.Exit()
+	time.Sleep(100 * time.Millisecond)
+
+	jar, _ := cookiejar.New(nil)
+	client := &http.Client{
+		Jar: jar,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	base := fmt.Sprintf("http://%s", nsqadmin2.RealHTTPAddr())
+	get := func(path string) *http.Response {
+		resp, err := client.Get(base + path)
+		test.Nil(t, err)
+		resp.Body.Close()
+		return resp
+	}
+
+	test.Equal(t, 401, get("/api/topics").StatusCode)
+	resp := get("/topics")
+	test.Equal(t, 302, resp.StatusCode)
+	test.Equal(t, "/oidc/login?next=%2Ftopics", resp.Header.Get("Location"))
+
+	resp = get("/oidc/login?next=/topics")
+	test.Equal(t, 302, resp.StatusCode)
+	authorize, _ := url.Parse(resp.Header.Get("Location"))
+	test.Equal(t, issuer.URL+"/authorize", fmt.Sprintf("%s://%s%s", authorize.Scheme, authorize.Host, authorize.Path))
+	test.Equal(t, "nsqadmin", authorize.Query().Get("client_id"))
+	issuer.nonce = authorize.Query().Get("nonce")
+
+	test.Equal(t, 400, get("/oidc/callback?code=the-code&state=forged").StatusCode)
+	resp = get("/oidc/callback?code=the-code&state=" + authorize.Query().Get("state"))
+	test.Equal(t, 302, resp.StatusCode)
+	test.Equal(t, "/topics", resp.Header.Get("Location"))
+	test.Equal(t, 200, get("/api/topics").StatusCode)
+
+	// the groups claim makes alice an admin
+	resp, err = client.Post(base+"/api/topics", "application/json",
+		bytes.NewBufferString(`{"topic":"oidc_topic"}`))
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 200, resp.StatusCode)
+	select {
+	case a := <-actions:
+		test.Equal(t, "create_topic", a.Action)
+		test.Equal(t, "alice@example.com", a.User)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no admin action notification")
+	}
+
+	test.Equal(t, 302, get("/oidc/logout").StatusCode)
+	test.Equal(t, 401, get("/api/topics").StatusCode)
+}
+
+func TestHTTPAudit(t *testing.T) {
+	dataPath, nsqds, nsqlookupds, nsqadmin1 := bootstrapNSQCluster(t)
+	defer os.RemoveAll(dataPath)
+	defer nsqds[0].Exit()
+	defer nsqlookupds[0].Exit()
+	defer nsqadmin1.Exit()
+
+	// the notification endpoint fails the first attempt
+	var attempts int32
+	actions := make(chan *AdminAction, 1)
+	notifications := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(503)
+			return
+		}
+		var a AdminAction
+		json.NewDecoder(req.Body).Decode(&a)
+		actions <- &a
+	}))
+	defer notifications.Close()
+
+	opts := NewOptions()
+	opts.HTTPAddress = "127.0.0.1:0"
+	opts.NSQLookupdHTTPAddresses = []string{nsqlookupds[0].RealHTTPAddr().String()}
+	opts.Logger = test.NewTestLogger(t)
+	opts.NotificationHTTPEndpoint = notifications.URL
+	opts.AuditLogFile = dataPath + "/audit.log"
+	nsqadmin2, err := New(opts)
+	test.Nil(t, err)
+	go func() {
+		err := nsqadmin2.Main()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer nsqadmin2.Exit()
+
+	topicName := "test_audit" + strconv.Itoa(int(time.Now().Unix()))
+	nsqds[0].GetTopic(topicName)
+	time.Sleep(100 * time.Millisecond)
+
+	base := fmt.Sprintf("http://%s", nsqadmin2.RealHTTPAddr())
+	req, _ := http.NewRequest("POST", base+"/api/topics/"+topicName, bytes.NewBufferString(`{"action":"pause"}`))
+	req.SetBasicAuth("alice", "")
+	resp, err := http.DefaultClient.Do(req)
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 200, resp.StatusCode)
+
+	select {
+	case a := <-actions:
+		test.Equal(t, "pause_topic", a.Action)
+		test.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+	case <-time.After(5 * time.Second):
+		t.Fatal("no admin action notification")
+	}
+
+	audit := func(query string) []*AdminAction {
+		resp, err := http.Get(base + "/api/audit?" + query)
+		test.Nil(t, err)
+		defer resp.Body.Close()
+		test.Equal(t, 200, resp.StatusCode)
+		var ret struct {
+			Actions []*AdminAction `json:"actions"`
+		}
+		test.Nil(t, json.NewDecoder(resp.Body).Decode(&ret))
+		return ret.Actions
+	}
+	list := audit("user=alice&topic=" + topicName)
+	test.Equal(t, 1, len(list))
+	test.Equal(t, "pause_topic", list[0].Action)
+	test.Equal(t, 0, len(audit("user=bob")))
+	test.Equal(t, 0, len(audit(fmt.Sprintf("since=%d", time.Now().Add(time.Hour).Unix()))))
+
+	// the action was written to the audit log file
+	data, err := ioutil.ReadFile(opts.AuditLogFile)
+	test.Nil(t, err)
+	test.Equal(t, true, strings.Contains(string(data), `"action":"pause_topic"`))
+}
+
+func TestHTTPClusters(t *testing.T) {
+	dataPath, nsqds, nsqlookupds, nsqadmin1 := bootstrapNSQCluster(t)
+	defer os.RemoveAll(dataPath)
+	defer nsqds[0].Exit()
+	defer nsqlookupds[0].Exit()
+	defer nsqadmin1.Exit()
+
+	opts := NewOptions()
+	opts.HTTPAddress = "127.0.0.1:0"
+	opts.NSQLookupdHTTPAddresses = []string{nsqlookupds[0].RealHTTPAddr().String()}
+	opts.Clusters = []string{"direct:nsqd-http-address=" + nsqds[0].RealHTTPAddr().String()}
+	opts.Logger = test.NewTestLogger(t)
+	nsqadmin2, err := New(opts)
+	test.Nil(t, err)
+	go func() {
+		err := nsqadmin2.Main()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer nsqadmin2.Exit()
+
+	topicName := "test_clusters" + strconv.Itoa(int(time.Now().Unix()))
+	nsqds[0].GetTopic(topicName)
+	time.Sleep(100 * time.Millisecond)
+
+	base := fmt.Sprintf("http://%s", nsqadmin2.RealHTTPAddr())
+	get := func(p string, v interface{}) int {
+		resp, err := http.Get(base + p)
+		test.Nil(t, err)
+		defer resp.Body.Close()
+		body, _ := ioutil.ReadAll(resp.Body)
+		if v != nil {
+			test.Nil(t, json.Unmarshal(body, v))
+		}
+		return resp.StatusCode
+	}
+
+	var clusters struct {
+		Clusters []clusterSummary `json:"clusters"`
+	}
+	test.Equal(t, 200, get("/api/clusters", &clusters))
+	test.Equal(t, 2, len(clusters.Clusters))
+	test.Equal(t, "default", clusters.Clusters[0].Name)
+	test.Equal(t, opts.NSQLookupdHTTPAddresses, clusters.Clusters[0].NSQLookupd)
+	test.Equal(t, "direct", clusters.Clusters[1].Name)
+	test.Equal(t, 1, clusters.Clusters[1].Nodes)
+	test.Equal(t, true, clusters.Clusters[1].Topics > 0)
+
+	// the routes of each cluster are namespaced, the default cluster is also
+	// served at the top level
+	for _, p := range []string{"/api/topics", "/clusters/default/api/topics", "/clusters/direct/api/topics"} {
+		var topics struct {
+			Topics []string `json:"topics"`
+		}
+		test.Equal(t, 200, get(p, &topics))
+		found := false
+		for _, topic := range topics.Topics {
+			found = found || topic == topicName
+		}
+		test.Equal(t, true, found)
+	}
+	test.Equal(t, 200, get("/clusters/direct/topics/"+topicName, nil))
+	test.Equal(t, 404, get("/clusters/bogus/topics", nil))
+	test.Equal(t, 404, get("/clusters/bogus/api/topics", nil))
+
+	// actions go to the cluster of the route and are audited on it
+	resp, err := http.Post(base+"/clusters/direct/api/topics/"+topicName, "application/json",
+		bytes.NewBufferString(`{"action":"pause"}`))
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 200, resp.StatusCode)
+	test.Equal(t, true, nsqds[0].GetTopic(topicName).IsPaused())
+
+	var audit struct {
+		Actions []*AdminAction `json:"actions"`
+	}
+	test.Equal(t, 200, get("/clusters/direct/api/audit", &audit))
+	test.Equal(t, 1, len(audit.Actions))
+	test.Equal(t, "direct", audit.Actions[0].Cluster)
+	test.Equal(t, 200, get("/api/audit", &audit))
+	test.Equal(t, 0, len(audit.Actions))
+}
+
 func TestHTTPEmptyTopicPOST(t *testing.T) {
 	dataPath, nsqds, nsqlookupds, nsqadmin1 := bootstrapNSQCluster(t)
 	defer os.RemoveAll(dataPath)
@@ -529,6 +1128,173 @@ func TestHTTPEmptyTopicPOST(t *testing.T) {
 	test.Equal(t, int64(0), topic.Depth())
 }
 
+func TestHTTPPublishPOST(t *testing.T) {
+	dataPath, nsqds, nsqlookupds, nsqadmin1 := bootstrapNSQCluster(t)
+	defer os.RemoveAll(dataPath)
+	defer nsqds[0].Exit()
+	defer nsqlookupds[0].Exit()
+	defer nsqadmin1.Exit()
+
+	topicName := "test_publish_post" + strconv.Itoa(int(time.Now().Unix()))
+	topic := nsqds[0].GetTopic(topicName)
+	time.Sleep(100 * time.Millisecond)
+
+	client := http.Client{}
+	url := fmt.Sprintf("http://%s/api/topics/%s", nsqadmin1.RealHTTPAddr(), topicName)
+	for _, tc := range []struct {
+		body   map[string]interface{}
+		status int
+	}{
+		{map[string]interface{}{"action": "publish", "messages": []string{"a", "b"}}, 200},
+		{map[string]interface{}{"action": "publish", "messages": []string{"c"},
+			"node": nsqds[0].RealHTTPAddr().String(), "defer": 1000}, 200},
+		{map[string]interface{}{"action": "publish", "messages": []string{"d"}, "node": "127.0.0.1:1"}, 400},
+		{map[string]interface{}{"action": "publish", "messages": []string{}}, 400},
+		{map[string]interface{}{"action": "publish", "messages": make([]string, maxPublishMessages+1)}, 400},
+		{map[string]interface{}{"action": "publish", "messages": []string{
+			strings.Repeat("e", maxPublishSize/2), strings.Repeat("f", maxPublishSize/2+1)}}, 413},
+		// larger than the --max-msg-size of nsqd
+		{map[string]interface{}{"action": "publish", "messages": []string{strings.Repeat("g", 2*1024*1024)}}, 502},
+	} {
+		body, _ := json.Marshal(tc.body)
+		req, _ := http.NewRequest("POST", url, bytes.NewBuffer(body))
+		resp, err := client.Do(req)
+		test.Nil(t, err)
+		resp.Body.Close()
+		test.Equal(t, tc.status, resp.StatusCode)
+	}
+
+	test.Equal(t, int64(3), topic.Depth())
+
+	// only the publishes which succeeded are admin actions
+	var publishes int
+	for _, a := range nsqadmin1.audit.recent {
+		if a.Action == "publish" {
+			publishes++
+		}
+	}
+	test.Equal(t, 2, publishes)
+}
+
+func TestHTTPBulkActionPOST(t *testing.T) {
+	dataPath, nsqds, nsqlookupds, nsqadmin1 := bootstrapNSQCluster(t)
+	defer os.RemoveAll(dataPath)
+	defer nsqds[0].Exit()
+	defer nsqlookupds[0].Exit()
+	defer nsqadmin1.Exit()
+
+	prefix := "test_bulk_action_post" + strconv.Itoa(int(time.Now().Unix()))
+	var channels []*nsqd.Channel
+	for _, name := range []string{"_a", "_b", "_other"} {
+		topic := nsqds[0].GetTopic(prefix + name)
+		channels = append(channels, topic.GetChannel("archive"), topic.GetChannel("live"))
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	client := http.Client{}
+	url := fmt.Sprintf("http://%s/api/bulk", nsqadmin1.RealHTTPAddr())
+	bulk := func(body map[string]interface{}) (int, []bulkResult) {
+		data, _ := json.Marshal(body)
+		req, _ := http.NewRequest("POST", url, bytes.NewBuffer(data))
+		resp, err := client.Do(req)
+		test.Nil(t, err)
+		defer resp.Body.Close()
+		var ret struct {
+			Results []bulkResult `json:"results"`
+		}
+		json.NewDecoder(resp.Body).Decode(&ret)
+		return resp.StatusCode, ret.Results
+	}
+
+	status, results := bulk(map[string]interface{}{
+		"action":   "pause",
+		"topics":   []string{prefix + "_?"},
+		"channels": []string{"arch*"},
+		"dry_run":  true,
+	})
+	test.Equal(t, 200, status)
+	test.Equal(t, []bulkResult{
+		{Topic: prefix + "_a", Channel: "archive", Status: "matched"},
+		{Topic: prefix + "_b", Channel: "archive", Status: "matched"},
+	}, results)
+	for _, c := range channels {
+		test.Equal(t, false, c.IsPaused())
+	}
+
+	status, results = bulk(map[string]interface{}{
+		"action":   "pause",
+		"topics":   []string{prefix + "_(a|other)"},
+		"channels": []string{"archive", "live"},
+		"regex":    true,
+	})
+	test.Equal(t, 200, status)
+	test.Equal(t, 4, len(results))
+	for _, r := range results {
+		test.Equal(t, "ok", r.Status)
+	}
+	for i, c := range channels {
+		test.Equal(t, i < 2 || i > 3, c.IsPaused())
+	}
+
+	status, _ = bulk(map[string]interface{}{"action": "tombstone", "topics": []string{"*"}})
+	test.Equal(t, 400, status)
+	status, _ = bulk(map[string]interface{}{"action": "pause", "topics": []string{"["}})
+	test.Equal(t, 400, status)
+}
+
+func TestHTTPDisconnectClientPOST(t *testing.T) {
+	dataPath, nsqds, nsqlookupds, nsqadmin1 := bootstrapNSQCluster(t)
+	defer os.RemoveAll(dataPath)
+	defer nsqds[0].Exit()
+	defer nsqlookupds[0].Exit()
+	defer nsqadmin1.Exit()
+
+	topicName := "test_disconnect_client_post" + strconv.Itoa(int(time.Now().Unix()))
+	conn, err := net.DialTimeout("tcp", nsqds[0].RealTCPAddr().String(), time.Second)
+	test.Nil(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("  V2SUB " + topicName + " ch\n"))
+	test.Nil(t, err)
+	time.Sleep(100 * time.Millisecond)
+
+	client := http.Client{}
+	url := fmt.Sprintf("http://%s/api/topics/%s/ch", nsqadmin1.RealHTTPAddr(), topicName)
+	resp, err := client.Get(url)
+	test.Nil(t, err)
+	var channel struct {
+		Clients []*clusterinfo.ClientStats `json:"clients"`
+	}
+	test.Nil(t, json.NewDecoder(resp.Body).Decode(&channel))
+	resp.Body.Close()
+	test.Equal(t, 1, len(channel.Clients))
+	node := channel.Clients[0].Node
+
+	for _, tc := range []struct {
+		node   string
+		status int
+	}{
+		{"127.0.0.1:1", 400},
+		{node, 200},
+	} {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action":    "disconnect",
+			"node":      tc.node,
+			"client_id": channel.Clients[0].ID,
+			"requeue":   true,
+		})
+		req, _ := http.NewRequest("POST", url, bytes.NewBuffer(body))
+		resp, err := client.Do(req)
+		test.Nil(t, err)
+		resp.Body.Close()
+		test.Equal(t, tc.status, resp.StatusCode)
+	}
+
+	// nsqd closed the connection
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	_, err = ioutil.ReadAll(conn)
+	test.Nil(t, err)
+}
+
 func TestHTTPEmptyChannelPOST(t *testing.T) {
 	dataPath, nsqds, nsqlookupds, nsqadmin1 := bootstrapNSQCluster(t)
 	defer os.RemoveAll(dataPath)
@@ -11,6 +11,7 @@ import (
 
 type AdminAction struct {
 	Action    string `json:"action"`
+	Cluster   string `json:"cluster"`
 	Topic     string `json:"topic"`
 	Channel   string `json:"channel,omitempty"`
 	Node      string `json:"node,omitempty"`
@@ -38,10 +39,19 @@ func basicAuthUser(req *http.Request) string {
 	return pair[0]
 }
 
-func (s *httpServer) notifyAdminAction(action, topic, channel, node string, req *http.Request) {
-	if s.nsqadmin.getOpts().NotificationHTTPEndpoint == "" {
-		return
+// actionUser is the user performing an admin action, the logged in user when
+// OIDC is enabled or else the basic auth user
+func (s *httpServer) actionUser(req *http.Request) string {
+	if s.nsqadmin.oidc != nil {
+		user, _ := s.requestIdentity(req)
+		return user
 	}
+	return basicAuthUser(req)
+}
+
+// notifyAdminAction records an admin action in the audit log and, when
+// --notification-http-endpoint is set, queues it to be POSTed there
+func (s *httpServer) notifyAdminAction(action, topic, channel, node string, req *http.Request) {
 	via, _ := os.Hostname()
 
 	u := url.URL{
@@ -56,16 +66,30 @@ func (s *httpServer) notifyAdminAction(action, topic, channel, node string, req
 
 	a := &AdminAction{
 		Action:    action,
+		Cluster:   s.requestCluster(req).name,
 		Topic:     topic,
 		Channel:   channel,
 		Node:      node,
 		Timestamp: time.Now().Unix(),
-		User:      basicAuthUser(req),
+		User:      s.actionUser(req),
 		RemoteIP:  req.RemoteAddr,
 		UserAgent: req.UserAgent(),
 		URL:       u.String(),
 		Via:       via,
 	}
+	err := s.nsqadmin.audit.append(a)
+	if err != nil {
+		s.nsqadmin.logf(LOG_ERROR, "failed to append admin action to audit log - %s", err)
+	}
+
+	if s.nsqadmin.getOpts().NotificationHTTPEndpoint == "" {
+		return
+	}
 	// Perform all work in a new goroutine so this never blocks
-	go func() { s.nsqadmin.notifications <- a }()
+	go func() {
+		select {
+		case s.nsqadmin.notifications <- a:
+		case <-s.nsqadmin.exitChan:
+		}
+	}()
 }
@@ -2,120 +2,122 @@ package nsqadmin
 
 import (
 	"bytes"
-	"crypto/tls"
-	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io/ioutil"
-	"log"
 	"net"
 	"net/http"
 	"net/url"
 	"os"
 	"path"
+	"sort"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/nsqio/nsq/internal/http_api"
+	"github.com/nsqio/nsq/internal/lg"
 	"github.com/nsqio/nsq/internal/util"
 	"github.com/nsqio/nsq/internal/version"
 )
 
+// the backoff between attempts to POST an admin action notification
+var (
+	notificationBackoff    = time.Second
+	notificationMaxBackoff = time.Minute
+)
+
 type NSQAdmin struct {
 	sync.RWMutex
-	opts                atomic.Value
-	httpListener        net.Listener
-	waitGroup           util.WaitGroupWrapper
-	notifications       chan *AdminAction
-	graphiteURL         *url.URL
-	httpClientTLSConfig *tls.Config
+	opts          atomic.Value
+	httpListener  net.Listener
+	waitGroup     util.WaitGroupWrapper
+	notifications chan *AdminAction
+	graphiteURL   *url.URL
+	clusters      []*cluster
+	accessControl *accessControl
+	oidc          *oidcProvider
+	alerts        *alertManager
+	audit         *auditLog
+	exitChan      chan int
 }
 
 func New(opts *Options) (*NSQAdmin, error) {
 	if opts.Logger == nil {
-		opts.Logger = log.New(os.Stderr, opts.LogPrefix, log.Ldate|log.Ltime|log.Lmicroseconds)
+		logger, err := lg.NewLogger(opts.LogFormat, os.Stderr, opts.LogPrefix, "nsqadmin")
+		if err != nil {
+			return nil, err
+		}
+		opts.Logger = logger
 	}
 
 	n := &NSQAdmin{
 		notifications: make(chan *AdminAction),
+		exitChan:      make(chan int),
 	}
 	n.swapOpts(opts)
 
-	if len(opts.NSQDHTTPAddresses) == 0 && len(opts.NSQLookupdHTTPAddresses) == 0 {
-		return nil, errors.New("--nsqd-http-address or --lookupd-http-address required")
+	clusters, err := newClusters(n, opts)
+	if err != nil {
+		return nil, err
 	}
+	n.clusters = clusters
 
-	if len(opts.NSQDHTTPAddresses) != 0 && len(opts.NSQLookupdHTTPAddresses) != 0 {
-		return nil, errors.New("use --nsqd-http-address or --lookupd-http-address not both")
+	if opts.ProxyGraphite {
+		url, err := url.Parse(opts.GraphiteURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse --graphite-url (%s) - %s", opts.GraphiteURL, err)
+		}
+		n.graphiteURL = url
 	}
 
-	if opts.HTTPClientTLSCert != "" && opts.HTTPClientTLSKey == "" {
-		return nil, errors.New("--http-client-tls-key must be specified with --http-client-tls-cert")
+	if opts.AllowConfigFromCIDR != "" {
+		_, _, err := net.ParseCIDR(opts.AllowConfigFromCIDR)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse --allow-config-from-cidr (%s) - %s", opts.AllowConfigFromCIDR, err)
+		}
 	}
 
-	if opts.HTTPClientTLSKey != "" && opts.HTTPClientTLSCert == "" {
-		return nil, errors.New("--http-client-tls-cert must be specified with --http-client-tls-key")
-	}
+	opts.BasePath = normalizeBasePath(opts.BasePath)
 
-	n.httpClientTLSConfig = &tls.Config{
-		InsecureSkipVerify: opts.HTTPClientTLSInsecureSkipVerify,
-	}
-	if opts.HTTPClientTLSCert != "" && opts.HTTPClientTLSKey != "" {
-		cert, err := tls.LoadX509KeyPair(opts.HTTPClientTLSCert, opts.HTTPClientTLSKey)
-		if err != nil {
-			return nil, fmt.Errorf("failed to LoadX509KeyPair %s, %s - %s",
-				opts.HTTPClientTLSCert, opts.HTTPClientTLSKey, err)
-		}
-		n.httpClientTLSConfig.Certificates = []tls.Certificate{cert}
+	n.accessControl, err = newAccessControl(opts)
+	if err != nil {
+		return nil, err
 	}
-	if opts.HTTPClientTLSRootCAFile != "" {
-		tlsCertPool := x509.NewCertPool()
-		caCertFile, err := ioutil.ReadFile(opts.HTTPClientTLSRootCAFile)
+
+	if opts.OIDCIssuerURL != "" {
+		n.oidc, err = newOIDCProvider(n)
 		if err != nil {
-			return nil, fmt.Errorf("failed to read TLS root CA file %s - %s",
-				opts.HTTPClientTLSRootCAFile, err)
-		}
-		if !tlsCertPool.AppendCertsFromPEM(caCertFile) {
-			return nil, fmt.Errorf("failed to AppendCertsFromPEM %s", opts.HTTPClientTLSRootCAFile)
+			return nil, err
 		}
-		n.httpClientTLSConfig.RootCAs = tlsCertPool
 	}
 
-	for _, address := range opts.NSQLookupdHTTPAddresses {
-		_, err := net.ResolveTCPAddr("tcp", address)
-		if err != nil {
-			return nil, fmt.Errorf("failed to resolve --lookupd-http-address (%s) - %s", address, err)
-		}
+	if opts.NotificationMaxAttempts < 1 {
+		return nil, errors.New("--notification-max-attempts must be at least 1")
 	}
 
-	for _, address := range opts.NSQDHTTPAddresses {
-		_, err := net.ResolveTCPAddr("tcp", address)
-		if err != nil {
-			return nil, fmt.Errorf("failed to resolve --nsqd-http-address (%s) - %s", address, err)
-		}
+	n.audit, err = newAuditLog(opts.AuditLogFile, opts.AuditLogMaxSize, opts.AuditLogMaxBackups)
+	if err != nil {
+		return nil, err
 	}
 
-	if opts.ProxyGraphite {
-		url, err := url.Parse(opts.GraphiteURL)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse --graphite-url (%s) - %s", opts.GraphiteURL, err)
-		}
-		n.graphiteURL = url
+	n.alerts = newAlertManager(n)
+	err = n.alerts.load()
+	if err != nil {
+		return nil, err
 	}
 
-	if opts.AllowConfigFromCIDR != "" {
-		_, _, err := net.ParseCIDR(opts.AllowConfigFromCIDR)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse --allow-config-from-cidr (%s) - %s", opts.AllowConfigFromCIDR, err)
+	if opts.HistoryInterval > 0 && opts.GraphiteURL == "" {
+		if opts.HistorySize < 2 {
+			return nil, errors.New("--history-size must be at least 2")
+		}
+		for _, c := range n.clusters {
+			c.history = newHistoryStore(opts.HistorySize)
 		}
 	}
 
-	opts.BasePath = normalizeBasePath(opts.BasePath)
-
 	n.logf(LOG_INFO, version.String("nsqadmin"))
 
-	var err error
 	n.httpListener, err = net.Listen("tcp", n.getOpts().HTTPAddress)
 	if err != nil {
 		return nil, fmt.Errorf("listen (%s) failed - %s", n.getOpts().HTTPAddress, err)
@@ -147,23 +149,85 @@ func (n *NSQAdmin) RealHTTPAddr() *net.TCPAddr {
 	return n.httpListener.Addr().(*net.TCPAddr)
 }
 
+// adminNotification is an admin action waiting to be POSTed
+type adminNotification struct {
+	content  []byte
+	attempts int
+	backoff  time.Duration
+	next     time.Time
+}
+
+// handleAdminActions POSTs admin actions to --notification-http-endpoint.
+//
+// Failed notifications are retried in the background, ordered by their next
+// attempt, so that an endpoint outage doesn't hold up newer actions.
 func (n *NSQAdmin) handleAdminActions() {
-	for action := range n.notifications {
-		content, err := json.Marshal(action)
-		if err != nil {
-			n.logf(LOG_ERROR, "failed to serialize admin action - %s", err)
-		}
-		httpclient := &http.Client{
-			Transport: http_api.NewDeadlineTransport(n.getOpts().HTTPClientConnectTimeout, n.getOpts().HTTPClientRequestTimeout),
+	httpclient := &http.Client{
+		Transport: http_api.NewDeadlineTransport(n.getOpts().HTTPClientConnectTimeout, n.getOpts().HTTPClientRequestTimeout),
+	}
+	var retries []*adminNotification
+	retry := func(p *adminNotification) {
+		i := sort.Search(len(retries), func(i int) bool { return retries[i].next.After(p.next) })
+		retries = append(retries, nil)
+		copy(retries[i+1:], retries[i:])
+		retries[i] = p
+	}
+	for {
+		var retryChan <-chan time.Time
+		if len(retries) > 0 {
+			retryChan = time.After(time.Until(retries[0].next))
 		}
-		n.logf(LOG_INFO, "POSTing notification to %s", n.getOpts().NotificationHTTPEndpoint)
-		resp, err := httpclient.Post(n.getOpts().NotificationHTTPEndpoint,
-			"application/json", bytes.NewBuffer(content))
-		if err != nil {
-			n.logf(LOG_ERROR, "failed to POST notification - %s", err)
+
+		select {
+		case action := <-n.notifications:
+			content, err := json.Marshal(action)
+			if err != nil {
+				n.logf(LOG_ERROR, "failed to serialize admin action - %s", err)
+				continue
+			}
+			p := &adminNotification{content: content, backoff: notificationBackoff}
+			if n.postAdminAction(httpclient, p) {
+				retry(p)
+			}
+		case <-retryChan:
+			p := retries[0]
+			retries = retries[1:]
+			if n.postAdminAction(httpclient, p) {
+				retry(p)
+			}
+		case <-n.exitChan:
+			return
 		}
+	}
+}
+
+// postAdminAction makes an attempt to POST a notification and returns whether
+// it should be retried, with an exponential backoff, up to
+// --notification-max-attempts times
+func (n *NSQAdmin) postAdminAction(httpclient *http.Client, p *adminNotification) bool {
+	p.attempts++
+	endpoint := n.getOpts().NotificationHTTPEndpoint
+	n.logf(LOG_INFO, "POSTing notification to %s", endpoint)
+	resp, err := httpclient.Post(endpoint, "application/json", bytes.NewBuffer(p.content))
+	if err == nil {
 		resp.Body.Close()
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return false
+		}
+		err = fmt.Errorf("got response %s", resp.Status)
+	}
+
+	if p.attempts >= n.getOpts().NotificationMaxAttempts {
+		n.logf(LOG_ERROR, "failed to POST notification after %d attempts - %s", p.attempts, err)
+		return false
+	}
+	n.logf(LOG_WARN, "failed to POST notification (attempt %d), retrying in %s - %s", p.attempts, p.backoff, err)
+	p.next = time.Now().Add(p.backoff)
+	p.backoff *= 2
+	if p.backoff > notificationMaxBackoff {
+		p.backoff = notificationMaxBackoff
 	}
+	return true
 }
 
 func (n *NSQAdmin) Main() error {
@@ -183,6 +247,7 @@ func (n *NSQAdmin) Main() error {
 		exitFunc(http_api.Serve(n.httpListener, http_api.CompressHandler(httpServer), "HTTP", n.logf))
 	})
 	n.waitGroup.Wrap(n.handleAdminActions)
+	n.waitGroup.Wrap(n.statsLoop)
 
 	err := <-exitCh
 	return err
@@ -192,6 +257,7 @@ func (n *NSQAdmin) Exit() {
 	if n.httpListener != nil {
 		n.httpListener.Close()
 	}
-	close(n.notifications)
+	close(n.exitChan)
 	n.waitGroup.Wait()
+	n.audit.close()
 }
@@ -1,14 +1,19 @@
 package nsqadmin
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/nsqio/nsq/internal/clusterinfo"
 	"github.com/nsqio/nsq/internal/lg"
 	"github.com/nsqio/nsq/internal/test"
 	"github.com/nsqio/nsq/nsqd"
@@ -101,3 +106,281 @@ func mustStartNSQD(opts *nsqd.Options) (*net.TCPAddr, *net.TCPAddr, *nsqd.NSQD)
 	}()
 	return nsqd.RealTCPAddr(), nsqd.RealHTTPAddr(), nsqd
 }
+
+func TestAlertEvaluate(t *testing.T) {
+	a := newAlertManager(nil)
+	for _, r := range []*AlertRule{
+		{Name: "backlog", Type: alertDepth, Topic: "orders*", Threshold: 100, For: "1m"},
+		{Name: "abandoned", Type: alertNoConsumers, Topic: "orders", Channel: "archive"},
+		{Name: "timeouts", Type: alertTimeoutRate, Threshold: 1},
+	} {
+		test.Nil(t, r.validate())
+		a.rules = append(a.rules, r)
+	}
+	test.NotNil(t, (&AlertRule{Name: "x", Type: "bogus"}).validate())
+	test.NotNil(t, (&AlertRule{Name: "x", Type: alertDepth, Topic: "["}).validate())
+
+	stats := func(depth int64, clients int, timeouts int64) map[string]*clusterinfo.ChannelStats {
+		return map[string]*clusterinfo.ChannelStats{
+			"orders:archive": {
+				TopicName:    "orders",
+				ChannelName:  "archive",
+				Depth:        depth,
+				ClientCount:  clients,
+				TimeoutCount: timeouts,
+			},
+			"payments:ch": {
+				TopicName:   "payments",
+				ChannelName: "ch",
+				Depth:       1000,
+				ClientCount: 1,
+			},
+		}
+	}
+	statuses := func(notifications []*AlertNotification) map[string]string {
+		m := make(map[string]string)
+		for _, n := range notifications {
+			m[n.Alert.ID] = n.Status
+		}
+		return m
+	}
+
+	now := time.Unix(1000, 0)
+	// no_consumers has no for and fires at once, the depth rule is pending
+	// and the other topic doesn't match it
+	test.Equal(t, map[string]string{"abandoned/orders/archive": "firing"},
+		statuses(a.evaluate(defaultClusterName, stats(500, 0, 0), false, now)))
+	test.Equal(t, 2, len(a.activeAlerts(defaultClusterName)))
+	test.Equal(t, "firing", a.activeAlerts(defaultClusterName)[0].State)
+
+	// still firing, no duplicate notification, depth fires after 1m and
+	// timeouts rise by 20 in 10s
+	now = now.Add(10 * time.Second)
+	test.Equal(t, map[string]string{"timeouts/orders/archive": "firing"},
+		statuses(a.evaluate(defaultClusterName, stats(500, 0, 20), false, now)))
+	now = now.Add(50 * time.Second)
+	test.Equal(t, map[string]string{
+		"backlog/orders/archive":  "firing",
+		"timeouts/orders/archive": "resolved",
+	}, statuses(a.evaluate(defaultClusterName, stats(500, 0, 20), false, now)))
+
+	// consumers come back and the backlog drains
+	now = now.Add(10 * time.Second)
+	test.Equal(t, map[string]string{
+		"backlog/orders/archive":   "resolved",
+		"abandoned/orders/archive": "resolved",
+	}, statuses(a.evaluate(defaultClusterName, stats(0, 1, 20), false, now)))
+	test.Equal(t, 0, len(a.activeAlerts(defaultClusterName)))
+
+	// alerts of a channel which goes away resolve
+	a.evaluate(defaultClusterName, stats(0, 0, 20), false, now.Add(10*time.Second))
+	test.Equal(t, map[string]string{},
+		statuses(a.evaluate(defaultClusterName, map[string]*clusterinfo.ChannelStats{}, true, now.Add(15*time.Second))))
+	test.Equal(t, map[string]string{"abandoned/orders/archive": "resolved"},
+		statuses(a.evaluate(defaultClusterName, map[string]*clusterinfo.ChannelStats{}, false, now.Add(20*time.Second))))
+
+	// the alerts of other clusters are kept apart
+	now = now.Add(30 * time.Second)
+	a.evaluate(defaultClusterName, stats(0, 0, 20), false, now)
+	test.Equal(t, map[string]string{"eu/abandoned/orders/archive": "firing"},
+		statuses(a.evaluate("eu", stats(0, 0, 20), false, now)))
+	test.Equal(t, map[string]string{},
+		statuses(a.evaluate("eu", stats(0, 0, 20), false, now.Add(10*time.Second))))
+	test.Equal(t, 1, len(a.activeAlerts("eu")))
+	test.Equal(t, "eu", a.activeAlerts("eu")[0].Cluster)
+	test.Equal(t, map[string]string{"abandoned/orders/archive": "resolved"},
+		statuses(a.evaluate(defaultClusterName, map[string]*clusterinfo.ChannelStats{}, false, now.Add(20*time.Second))))
+	test.Equal(t, 1, len(a.activeAlerts("eu")))
+}
+
+func TestParseClusterOptions(t *testing.T) {
+	opts := NewOptions()
+	opts.HTTPClientAuthSecret = "top-secret"
+
+	c, err := parseClusterOptions("eu:lookupd-http-address=127.0.0.1:4161,lookupd-http-address=127.0.0.2:4161,"+
+		"http-client-tls-insecure-skip-verify=true", opts)
+	test.Nil(t, err)
+	test.Equal(t, "eu", c.Name)
+	test.Equal(t, []string{"127.0.0.1:4161", "127.0.0.2:4161"}, c.NSQLookupdHTTPAddresses)
+	test.Equal(t, true, c.HTTPClientTLSInsecureSkipVerify)
+	test.Equal(t, "top-secret", c.HTTPClientAuthSecret)
+
+	c, err = parseClusterOptions("us:nsqd-http-address=127.0.0.1:4151,http-client-auth-secret=us-secret", opts)
+	test.Nil(t, err)
+	test.Equal(t, []string{"127.0.0.1:4151"}, c.NSQDHTTPAddresses)
+	test.Equal(t, "us-secret", c.HTTPClientAuthSecret)
+
+	for _, s := range []string{
+		"eu",
+		"e/u:lookupd-http-address=127.0.0.1:4161",
+		"eu:",
+		"eu:lookupd-http-address",
+		"eu:bogus=1",
+		"eu:http-client-tls-insecure-skip-verify=maybe",
+		"eu:lookupd-http-address=127.0.0.1:4161,nsqd-http-address=127.0.0.1:4151",
+	} {
+		_, err := parseClusterOptions(s, opts)
+		test.NotNil(t, err)
+	}
+
+	opts.NSQLookupdHTTPAddresses = []string{"127.0.0.1:4161"}
+	opts.Clusters = []string{"default:lookupd-http-address=127.0.0.2:4161"}
+	_, err = New(opts)
+	test.Equal(t, `duplicate cluster "default"`, fmt.Sprintf("%s", err))
+}
+
+func TestHistoryStore(t *testing.T) {
+	h := newHistoryStore(3)
+	topicStats := func(depth int64, messages int64) []*clusterinfo.TopicStats {
+		return []*clusterinfo.TopicStats{
+			{Node: "n1:4151", TopicName: "t", Depth: depth, MessageCount: messages},
+			{Node: "n2:4151", TopicName: "t", Depth: depth, MessageCount: messages},
+		}
+	}
+	depth := seriesKey{typ: historyTopic, node: "*", topic: "t", metric: "depth"}
+	rate := seriesKey{typ: historyTopic, node: "n1:4151", topic: "t", metric: "message_count"}
+
+	now := time.Unix(1000, 0)
+	h.record(newHistorySample(topicStats(5, 100)), now)
+	h.record(newHistorySample(topicStats(7, 200)), now.Add(10*time.Second))
+	// the counter went backwards, no rate
+	h.record(newHistorySample(topicStats(9, 50)), now.Add(20*time.Second))
+	h.record(newHistorySample(topicStats(11, 150)), now.Add(30*time.Second))
+
+	// the oldest sample was overwritten, depth is summed across nodes
+	test.Equal(t, [][2]float64{{1010, 14}, {1020, 18}, {1030, 22}}, h.get(depth, time.Time{}))
+	test.Equal(t, [][2]float64{{1010, 10}, {1030, 10}}, h.get(rate, time.Time{}))
+	test.Equal(t, [][2]float64{{1030, 22}}, h.get(depth, now.Add(25*time.Second)))
+	test.Equal(t, []string{"depth", "message_count"}, h.metrics(depth))
+
+	// series of a topic which went away are dropped once out of the buffer
+	for i := 4; i < 7; i++ {
+		h.record(historySample{}, now.Add(time.Duration(i)*10*time.Second))
+	}
+	test.Equal(t, 0, len(h.series))
+}
+
+func TestAuditLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nsqadmin-test-")
+	test.Nil(t, err)
+	defer os.RemoveAll(dir)
+	fileName := dir + "/audit.log"
+
+	// every action ends up in a file of its own
+	l, err := newAuditLog(fileName, 1, 5)
+	test.Nil(t, err)
+	for i, a := range []*AdminAction{
+		{Action: "pause_topic", Topic: "a", User: "alice", Timestamp: 100},
+		{Action: "empty_channel", Topic: "a", Channel: "ch", User: "bob", Timestamp: 200},
+		{Action: "delete_topic", Topic: "b", User: "alice", Timestamp: 300},
+	} {
+		test.Nil(t, l.append(a))
+		if i == 1 {
+			// actions are appended to what is already in the file
+			test.Nil(t, l.close())
+			// an oversized line is skipped rather than failing the query
+			f, err := os.OpenFile(fileName, os.O_WRONLY|os.O_APPEND, 0600)
+			test.Nil(t, err)
+			_, err = f.Write(append(bytes.Repeat([]byte("x"), 2*maxAuditLineSize), '\n'))
+			test.Nil(t, err)
+			test.Nil(t, f.Close())
+			l, err = newAuditLog(fileName, 1, 5)
+			test.Nil(t, err)
+		}
+	}
+	defer l.close()
+
+	actions := func(filter *auditFilter, visible func(*AdminAction) bool) []string {
+		list, err := l.query(filter, visible)
+		test.Nil(t, err)
+		var names []string
+		for _, a := range list {
+			names = append(names, a.Action)
+		}
+		return names
+	}
+	test.Equal(t, []string{"delete_topic", "empty_channel", "pause_topic"}, actions(&auditFilter{}, nil))
+	test.Equal(t, []string{"delete_topic", "pause_topic"}, actions(&auditFilter{User: "alice"}, nil))
+	test.Equal(t, []string{"empty_channel", "pause_topic"}, actions(&auditFilter{Topic: "a"}, nil))
+	test.Equal(t, []string{"empty_channel"}, actions(&auditFilter{Since: 150, Until: 250}, nil))
+	test.Equal(t, []string{"delete_topic"}, actions(&auditFilter{Limit: 1}, nil))
+	test.Equal(t, []string{"delete_topic"}, actions(&auditFilter{}, func(a *AdminAction) bool {
+		return a.Topic == "b"
+	}))
+}
+
+func TestAdminActionRetries(t *testing.T) {
+	// notifications for topic "a" always fail
+	actions := make(chan string, 2)
+	endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var a AdminAction
+		json.NewDecoder(req.Body).Decode(&a)
+		if a.Topic == "a" {
+			w.WriteHeader(503)
+			return
+		}
+		actions <- a.Topic
+	}))
+	defer endpoint.Close()
+
+	opts := NewOptions()
+	opts.HTTPAddress = "127.0.0.1:0"
+	opts.NSQDHTTPAddresses = []string{"127.0.0.1:0"}
+	opts.NotificationHTTPEndpoint = endpoint.URL
+	opts.NotificationMaxAttempts = 10
+	opts.Logger = test.NewTestLogger(t)
+	nsqadmin, err := New(opts)
+	test.Nil(t, err)
+	go func() {
+		err := nsqadmin.Main()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer nsqadmin.Exit()
+
+	// retrying "a" doesn't hold up "b"
+	start := time.Now()
+	nsqadmin.notifications <- &AdminAction{Action: "pause_topic", Topic: "a"}
+	nsqadmin.notifications <- &AdminAction{Action: "pause_topic", Topic: "b"}
+	select {
+	case topic := <-actions:
+		test.Equal(t, "b", topic)
+		test.Equal(t, true, time.Since(start) < notificationBackoff)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no admin action notification")
+	}
+}
+
+func TestAccessControl(t *testing.T) {
+	opts := NewOptions()
+	a, err := newAccessControl(opts)
+	test.Nil(t, err)
+	test.Equal(t, roleAdmin, a.roleFor("", nil, "orders"))
+
+	opts.AdminUsers = []string{"matt"}
+	opts.RoleBindings = []string{
+		"operator:user:alice:orders*",
+		"admin:group:payments:payments_*",
+		"operator:group:oncall",
+	}
+	a, err = newAccessControl(opts)
+	test.Nil(t, err)
+	test.Equal(t, roleAdmin, a.roleFor("matt", nil, ""))
+	test.Equal(t, roleViewer, a.roleFor("bob", nil, "orders"))
+	test.Equal(t, roleOperator, a.roleFor("alice", nil, "orders_eu"))
+	test.Equal(t, roleViewer, a.roleFor("alice", nil, "payments_eu"))
+	test.Equal(t, roleViewer, a.roleFor("alice", nil, ""))
+	test.Equal(t, roleAdmin, a.roleFor("bob", []string{"oncall", "payments"}, "payments_eu"))
+	test.Equal(t, roleOperator, a.roleFor("bob", []string{"oncall", "payments"}, "orders"))
+
+	for _, s := range []string{"root:user:matt", "admin:team:x", "admin:user:", "admin:user:matt:["} {
+		opts.RoleBindings = []string{s}
+		_, err = newAccessControl(opts)
+		test.NotNil(t, err)
+	}
+	opts.RoleBindings = nil
+	opts.DefaultRole = "nobody"
+	_, err = newAccessControl(opts)
+	test.NotNil(t, err)
+}
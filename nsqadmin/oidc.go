@@ -0,0 +1,452 @@
+package nsqadmin
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/nsqio/nsq/internal/http_api"
+)
+
+const (
+	oidcSessionCookie = "nsqadmin_session"
+	oidcStateCookie   = "nsqadmin_oidc_state"
+	oidcStateTimeout  = 10 * time.Minute
+)
+
+// oidcSession is the identity of a user logged in through the issuer, it is
+// kept in a signed cookie so nsqadmin holds no session state
+type oidcSession struct {
+	User    string   `json:"user"`
+	Groups  []string `json:"groups,omitempty"`
+	Expires int64    `json:"expires"`
+}
+
+// oidcState is kept in a signed cookie for the duration of a login, to check
+// the callback belongs to a login started by the same browser
+type oidcState struct {
+	State   string `json:"state"`
+	Nonce   string `json:"nonce"`
+	Next    string `json:"next"`
+	Expires int64  `json:"expires"`
+}
+
+type oidcProviderConfig struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// oidcProvider implements the authorization code flow against
+// --oidc-issuer-url, the issuer configuration and signing keys are fetched
+// on first use and the keys again when a token is signed by an unknown key
+type oidcProvider struct {
+	sync.Mutex
+	nsqadmin   *NSQAdmin
+	httpclient *http.Client
+	cookieKey  []byte
+	config     *oidcProviderConfig
+	keys       map[string]*rsa.PublicKey
+}
+
+func newOIDCProvider(n *NSQAdmin) (*oidcProvider, error) {
+	opts := n.getOpts()
+	if opts.OIDCClientID == "" {
+		return nil, errors.New("--oidc-client-id required with --oidc-issuer-url")
+	}
+	if _, err := url.ParseRequestURI(opts.OIDCRedirectURL); err != nil {
+		return nil, fmt.Errorf("invalid --oidc-redirect-url %q", opts.OIDCRedirectURL)
+	}
+
+	cookieKey := []byte(opts.OIDCCookieSecret)
+	if len(cookieKey) == 0 {
+		// sessions don't survive a restart nor work across several nsqadmin
+		cookieKey = make([]byte, 32)
+		if _, err := rand.Read(cookieKey); err != nil {
+			return nil, err
+		}
+		n.logf(LOG_WARN, "OIDC: --oidc-cookie-secret not set, sessions will not survive a restart")
+	}
+
+	return &oidcProvider{
+		nsqadmin: n,
+		httpclient: &http.Client{
+			Transport: http_api.NewDeadlineTransport(opts.HTTPClientConnectTimeout, opts.HTTPClientRequestTimeout),
+		},
+		cookieKey: cookieKey,
+	}, nil
+}
+
+func (o *oidcProvider) getJSON(endpoint string, v interface{}) error {
+	resp, err := o.httpclient.Get(endpoint)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("got response %s from %s", resp.Status, endpoint)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// providerConfig returns the issuer configuration, from its discovery document
+func (o *oidcProvider) providerConfig() (*oidcProviderConfig, error) {
+	o.Lock()
+	defer o.Unlock()
+	if o.config != nil {
+		return o.config, nil
+	}
+	issuer := strings.TrimSuffix(o.nsqadmin.getOpts().OIDCIssuerURL, "/")
+	var config oidcProviderConfig
+	err := o.getJSON(issuer+"/.well-known/openid-configuration", &config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover issuer - %s", err)
+	}
+	if config.Issuer != issuer {
+		return nil, fmt.Errorf("issuer %q does not match --oidc-issuer-url", config.Issuer)
+	}
+	o.config = &config
+	return o.config, nil
+}
+
+// key returns the RSA public key with id kid, refreshing the keys when it
+// isn't known
+func (o *oidcProvider) key(config *oidcProviderConfig, kid string) (*rsa.PublicKey, error) {
+	o.Lock()
+	defer o.Unlock()
+	if k, ok := o.keys[kid]; ok {
+		return k, nil
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	err := o.getJSON(config.JWKSURI, &jwks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get keys - %s", err)
+	}
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		n, err1 := base64.RawURLEncoding.DecodeString(k.N)
+		e, err2 := base64.RawURLEncoding.DecodeString(k.E)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	o.keys = keys
+	k, ok := keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", kid)
+	}
+	return k, nil
+}
+
+// verifyIDToken checks the signature and claims of an RS256 ID token and
+// returns its claims
+func (o *oidcProvider) verifyIDToken(config *oidcProviderConfig, token string, nonce string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	data, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err == nil {
+		err = json.Unmarshal(data, &header)
+	}
+	if err != nil {
+		return nil, errors.New("malformed token header")
+	}
+	if header.Alg != "RS256" {
+		return nil, fmt.Errorf("unsupported token algorithm %q", header.Alg)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.New("malformed token signature")
+	}
+	key, err := o.key(config, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	err = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature)
+	if err != nil {
+		return nil, errors.New("invalid token signature")
+	}
+
+	var claims map[string]interface{}
+	data, err = base64.RawURLEncoding.DecodeString(parts[1])
+	if err == nil {
+		err = json.Unmarshal(data, &claims)
+	}
+	if err != nil {
+		return nil, errors.New("malformed token claims")
+	}
+	if claims["iss"] != config.Issuer {
+		return nil, fmt.Errorf("invalid token issuer %v", claims["iss"])
+	}
+	clientID := o.nsqadmin.getOpts().OIDCClientID
+	audOK := claims["aud"] == clientID
+	if auds, ok := claims["aud"].([]interface{}); ok {
+		for _, aud := range auds {
+			audOK = audOK || aud == clientID
+		}
+	}
+	if !audOK {
+		return nil, fmt.Errorf("invalid token audience %v", claims["aud"])
+	}
+	if exp, ok := claims["exp"].(float64); !ok || time.Now().Unix() > int64(exp) {
+		return nil, errors.New("token expired")
+	}
+	if claims["nonce"] != nonce {
+		return nil, errors.New("invalid token nonce")
+	}
+	return claims, nil
+}
+
+// exchange trades an authorization code for the claims of its ID token
+func (o *oidcProvider) exchange(code string, nonce string) (map[string]interface{}, error) {
+	config, err := o.providerConfig()
+	if err != nil {
+		return nil, err
+	}
+	opts := o.nsqadmin.getOpts()
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", opts.OIDCRedirectURL)
+	req, err := http.NewRequest("POST", config.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(opts.OIDCClientID), url.QueryEscape(opts.OIDCClientSecret))
+	resp, err := o.httpclient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("token endpoint returned %s - %s", resp.Status, body)
+	}
+	var token struct {
+		IDToken string `json:"id_token"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&token)
+	if err != nil || token.IDToken == "" {
+		return nil, errors.New("token endpoint returned no id_token")
+	}
+	return o.verifyIDToken(config, token.IDToken, nonce)
+}
+
+func (o *oidcProvider) sign(v interface{}) string {
+	data, _ := json.Marshal(v)
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	mac := hmac.New(sha256.New, o.cookieKey)
+	mac.Write([]byte(payload))
+	return payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (o *oidcProvider) verify(s string, v interface{}) bool {
+	i := strings.LastIndex(s, ".")
+	if i < 0 {
+		return false
+	}
+	mac := hmac.New(sha256.New, o.cookieKey)
+	mac.Write([]byte(s[:i]))
+	signature, err := base64.RawURLEncoding.DecodeString(s[i+1:])
+	if err != nil || !hmac.Equal(signature, mac.Sum(nil)) {
+		return false
+	}
+	data, err := base64.RawURLEncoding.DecodeString(s[:i])
+	return err == nil && json.Unmarshal(data, v) == nil
+}
+
+// session returns the identity of a logged in user, nil when there is none
+func (o *oidcProvider) session(req *http.Request) *oidcSession {
+	c, err := req.Cookie(oidcSessionCookie)
+	if err != nil {
+		return nil
+	}
+	var session oidcSession
+	if !o.verify(c.Value, &session) || time.Now().Unix() > session.Expires {
+		return nil
+	}
+	return &session
+}
+
+// respondErr writes an error response for the login handlers, which write
+// redirects themselves and so are not decorated with http_api.V1
+func respondErr(w http.ResponseWriter, code int, text string) error {
+	err := http_api.Err{code, text}
+	http_api.RespondV1(w, code, err)
+	return err
+}
+
+func randomToken() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func (s *httpServer) setCookie(w http.ResponseWriter, name string, value string, maxAge int) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     s.basePath,
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   strings.HasPrefix(s.nsqadmin.getOpts().OIDCRedirectURL, "https://"),
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// oidcLoginHandler redirects to the issuer to authenticate, next is where
+// to return to once logged in
+func (s *httpServer) oidcLoginHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	o := s.nsqadmin.oidc
+	config, err := o.providerConfig()
+	if err != nil {
+		s.nsqadmin.logf(LOG_ERROR, "OIDC: %s", err)
+		return nil, respondErr(w, 502, "UPSTREAM_ERROR")
+	}
+
+	next := req.URL.Query().Get("next")
+	if !strings.HasPrefix(next, "/") || strings.HasPrefix(next, "//") {
+		next = s.basePath
+	}
+	state := &oidcState{
+		State:   randomToken(),
+		Nonce:   randomToken(),
+		Next:    next,
+		Expires: time.Now().Add(oidcStateTimeout).Unix(),
+	}
+	s.setCookie(w, oidcStateCookie, o.sign(state), int(oidcStateTimeout/time.Second))
+
+	opts := s.nsqadmin.getOpts()
+	q := url.Values{}
+	q.Set("response_type", "code")
+	q.Set("client_id", opts.OIDCClientID)
+	q.Set("redirect_uri", opts.OIDCRedirectURL)
+	q.Set("scope", opts.OIDCScopes)
+	q.Set("state", state.State)
+	q.Set("nonce", state.Nonce)
+	sep := "?"
+	if strings.Contains(config.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	http.Redirect(w, req, config.AuthorizationEndpoint+sep+q.Encode(), http.StatusFound)
+	return nil, nil
+}
+
+// oidcCallbackHandler completes a login, exchanging the authorization code
+// for the identity of the user which is kept in a session cookie
+func (s *httpServer) oidcCallbackHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	o := s.nsqadmin.oidc
+	q := req.URL.Query()
+	if e := q.Get("error"); e != "" {
+		s.nsqadmin.logf(LOG_WARN, "OIDC: login failed - %s %s", e, q.Get("error_description"))
+		return nil, respondErr(w, 403, "LOGIN_FAILED")
+	}
+
+	var state oidcState
+	c, err := req.Cookie(oidcStateCookie)
+	if err != nil || !o.verify(c.Value, &state) ||
+		time.Now().Unix() > state.Expires || q.Get("state") != state.State {
+		return nil, respondErr(w, 400, "INVALID_STATE")
+	}
+	s.setCookie(w, oidcStateCookie, "", -1)
+
+	claims, err := o.exchange(q.Get("code"), state.Nonce)
+	if err != nil {
+		s.nsqadmin.logf(LOG_WARN, "OIDC: login failed - %s", err)
+		return nil, respondErr(w, 403, "LOGIN_FAILED")
+	}
+
+	opts := s.nsqadmin.getOpts()
+	user, _ := claims[opts.OIDCUsernameClaim].(string)
+	if user == "" {
+		user, _ = claims["sub"].(string)
+	}
+	session := &oidcSession{
+		User:    user,
+		Expires: time.Now().Add(opts.OIDCSessionDuration).Unix(),
+	}
+	switch groups := claims[opts.OIDCGroupsClaim].(type) {
+	case []interface{}:
+		for _, g := range groups {
+			if g, ok := g.(string); ok {
+				session.Groups = append(session.Groups, g)
+			}
+		}
+	case string:
+		session.Groups = []string{groups}
+	}
+	s.setCookie(w, oidcSessionCookie, o.sign(session), int(opts.OIDCSessionDuration/time.Second))
+	s.nsqadmin.logf(LOG_INFO, "OIDC: %s logged in", session.User)
+
+	http.Redirect(w, req, state.Next, http.StatusFound)
+	return nil, nil
+}
+
+func (s *httpServer) oidcLogoutHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	s.setCookie(w, oidcSessionCookie, "", -1)
+	http.Redirect(w, req, s.basePath, http.StatusFound)
+	return nil, nil
+}
+
+// requireLogin returns false, after responding, to requests without a
+// session: pages redirect to the login and the API responds 401
+func (s *httpServer) requireLogin(w http.ResponseWriter, req *http.Request) bool {
+	rel := strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(s.basePath, "/"))
+	for _, prefix := range []string{"/ping", "/static/", "/fonts/", "/oidc/", "/config/"} {
+		if strings.HasPrefix(rel, prefix) {
+			return true
+		}
+	}
+	if s.nsqadmin.oidc.session(req) != nil {
+		return true
+	}
+	if req.Method == "GET" && !strings.HasPrefix(rel, "/api/") {
+		login := fmt.Sprintf("%s?next=%s", path.Join(s.basePath, "/oidc/login"), url.QueryEscape(req.URL.RequestURI()))
+		http.Redirect(w, req, login, http.StatusFound)
+		return false
+	}
+	http_api.RespondV1(w, 401, "UNAUTHORIZED")
+	return false
+}
@@ -9,6 +9,7 @@ import (
 type Options struct {
 	LogLevel  lg.LogLevel `flag:"log-level"`
 	LogPrefix string      `flag:"log-prefix"`
+	LogFormat string      `flag:"log-format"`
 	Logger    Logger
 
 	HTTPAddress string `flag:"http-address"`
@@ -27,6 +28,7 @@ type Options struct {
 
 	NSQLookupdHTTPAddresses []string `flag:"lookupd-http-address" cfg:"nsqlookupd_http_addresses"`
 	NSQDHTTPAddresses       []string `flag:"nsqd-http-address" cfg:"nsqd_http_addresses"`
+	Clusters                []string `flag:"cluster" cfg:"clusters"`
 
 	HTTPClientConnectTimeout time.Duration `flag:"http-client-connect-timeout"`
 	HTTPClientRequestTimeout time.Duration `flag:"http-client-request-timeout"`
@@ -35,18 +37,44 @@ type Options struct {
 	HTTPClientTLSRootCAFile         string `flag:"http-client-tls-root-ca-file"`
 	HTTPClientTLSCert               string `flag:"http-client-tls-cert"`
 	HTTPClientTLSKey                string `flag:"http-client-tls-key"`
+	HTTPClientAuthSecret            string `flag:"http-client-auth-secret"`
 
 	AllowConfigFromCIDR string `flag:"allow-config-from-cidr"`
 
 	NotificationHTTPEndpoint string `flag:"notification-http-endpoint"`
+	NotificationMaxAttempts  int    `flag:"notification-max-attempts"`
+	AuditLogFile             string `flag:"audit-log-file"`
+	AuditLogMaxSize          int64  `flag:"audit-log-max-size"`
+	AuditLogMaxBackups       int    `flag:"audit-log-max-backups"`
 
-	AclHttpHeader string   `flag:"acl-http-header"`
-	AdminUsers    []string `flag:"admin-user" cfg:"admin_users"`
+	AlertRulesFile   string        `flag:"alert-rules-file"`
+	AlertInterval    time.Duration `flag:"alert-interval"`
+	AlertWebhookURLs []string      `flag:"alert-webhook-url" cfg:"alert_webhook_urls"`
+
+	HistoryInterval time.Duration `flag:"history-interval"`
+	HistorySize     int           `flag:"history-size"`
+
+	AclHttpHeader       string   `flag:"acl-http-header"`
+	AclGroupsHttpHeader string   `flag:"acl-groups-http-header"`
+	AdminUsers          []string `flag:"admin-user" cfg:"admin_users"`
+	RoleBindings        []string `flag:"role-binding" cfg:"role_bindings"`
+	DefaultRole         string   `flag:"default-role"`
+
+	OIDCIssuerURL       string        `flag:"oidc-issuer-url"`
+	OIDCClientID        string        `flag:"oidc-client-id"`
+	OIDCClientSecret    string        `flag:"oidc-client-secret"`
+	OIDCRedirectURL     string        `flag:"oidc-redirect-url"`
+	OIDCScopes          string        `flag:"oidc-scopes"`
+	OIDCUsernameClaim   string        `flag:"oidc-username-claim"`
+	OIDCGroupsClaim     string        `flag:"oidc-groups-claim"`
+	OIDCCookieSecret    string        `flag:"oidc-cookie-secret"`
+	OIDCSessionDuration time.Duration `flag:"oidc-session-duration"`
 }
 
 func NewOptions() *Options {
 	return &Options{
 		LogPrefix:                "[nsqadmin] ",
+		LogFormat:                "text",
 		LogLevel:                 lg.INFO,
 		HTTPAddress:              "0.0.0.0:4171",
 		BasePath:                 "/",
@@ -57,7 +85,20 @@ func NewOptions() *Options {
 		HTTPClientConnectTimeout: 2 * time.Second,
 		HTTPClientRequestTimeout: 5 * time.Second,
 		AllowConfigFromCIDR:      "127.0.0.1/8",
+		NotificationMaxAttempts:  5,
+		AuditLogMaxSize:          100 * 1024 * 1024,
+		AuditLogMaxBackups:       5,
+		AlertInterval:            30 * time.Second,
+		AlertWebhookURLs:         []string{},
+		HistoryInterval:          60 * time.Second,
+		HistorySize:              120,
 		AclHttpHeader:            "X-Forwarded-User",
 		AdminUsers:               []string{},
+		RoleBindings:             []string{},
+		DefaultRole:              "viewer",
+		OIDCScopes:               "openid profile email",
+		OIDCUsernameClaim:        "email",
+		OIDCGroupsClaim:          "groups",
+		OIDCSessionDuration:      12 * time.Hour,
 	}
 }
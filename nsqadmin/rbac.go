@@ -0,0 +1,185 @@
+package nsqadmin
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// role is what a user may do, each role may also do everything the lower
+// ones may
+type role int
+
+const (
+	roleNone     role = iota
+	roleViewer        // read only
+	roleOperator      // pause, unpause and empty topics and channels, edit their metadata
+	roleAdmin         // create, delete and tombstone topics and channels, manage alert rules
+)
+
+var roleNames = map[string]role{
+	"viewer":   roleViewer,
+	"operator": roleOperator,
+	"admin":    roleAdmin,
+}
+
+func (r role) String() string {
+	for name, v := range roleNames {
+		if v == r {
+			return name
+		}
+	}
+	return "none"
+}
+
+func parseRole(s string) (role, error) {
+	r, ok := roleNames[s]
+	if !ok {
+		return roleNone, fmt.Errorf("invalid role %q", s)
+	}
+	return r, nil
+}
+
+// roleBinding grants a role to a user or to the members of a group, on every
+// topic or, with a topic pattern, on the topics (and their channels) whose
+// name matches it
+type roleBinding struct {
+	role         role
+	user         string
+	group        string
+	topicPattern string
+}
+
+// parseRoleBinding parses a binding given as ROLE:user:NAME[:TOPIC_PATTERN]
+// or ROLE:group:NAME[:TOPIC_PATTERN]
+func parseRoleBinding(s string) (*roleBinding, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 3 || len(parts) > 4 || parts[2] == "" {
+		return nil, fmt.Errorf("invalid role binding %q (expected ROLE:user|group:NAME[:TOPIC_PATTERN])", s)
+	}
+	r, err := parseRole(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid role binding %q - %s", s, err)
+	}
+	b := &roleBinding{role: r}
+	switch parts[1] {
+	case "user":
+		b.user = parts[2]
+	case "group":
+		b.group = parts[2]
+	default:
+		return nil, fmt.Errorf("invalid role binding %q - %q is neither user nor group", s, parts[1])
+	}
+	if len(parts) == 4 {
+		if _, err := path.Match(parts[3], ""); err != nil || parts[3] == "" {
+			return nil, fmt.Errorf("invalid role binding %q - invalid topic pattern %q", s, parts[3])
+		}
+		b.topicPattern = parts[3]
+	}
+	return b, nil
+}
+
+// accessControl maps the user (and groups) of a request to its role on a
+// topic
+type accessControl struct {
+	bindings    []*roleBinding
+	defaultRole role
+}
+
+// newAccessControl builds the bindings from --role-binding and --admin-user,
+// when neither is set every user is an admin
+func newAccessControl(opts *Options) (*accessControl, error) {
+	a := &accessControl{}
+	if len(opts.RoleBindings) == 0 && len(opts.AdminUsers) == 0 {
+		a.defaultRole = roleAdmin
+		return a, nil
+	}
+
+	r, err := parseRole(opts.DefaultRole)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse --default-role - %s", err)
+	}
+	a.defaultRole = r
+	for _, user := range opts.AdminUsers {
+		a.bindings = append(a.bindings, &roleBinding{role: roleAdmin, user: user})
+	}
+	for _, s := range opts.RoleBindings {
+		b, err := parseRoleBinding(s)
+		if err != nil {
+			return nil, err
+		}
+		a.bindings = append(a.bindings, b)
+	}
+	return a, nil
+}
+
+// roleFor returns the highest role of user, a member of groups, on topic.
+// With an empty topic only the bindings on every topic apply.
+func (a *accessControl) roleFor(user string, groups []string, topic string) role {
+	r := a.defaultRole
+	for _, b := range a.bindings {
+		if b.role <= r {
+			continue
+		}
+		if b.user != "" && b.user != user {
+			continue
+		}
+		if b.group != "" && !inGroups(b.group, groups) {
+			continue
+		}
+		if b.topicPattern != "" {
+			if ok, _ := path.Match(b.topicPattern, topic); !ok || topic == "" {
+				continue
+			}
+		}
+		r = b.role
+	}
+	return r
+}
+
+func inGroups(group string, groups []string) bool {
+	for _, g := range groups {
+		if g == group {
+			return true
+		}
+	}
+	return false
+}
+
+// requestIdentity returns the user of req and its groups, from the OIDC
+// session when logins are enabled or else as given by upstream proxies in
+// --acl-http-header and --acl-groups-http-header
+func (s *httpServer) requestIdentity(req *http.Request) (string, []string) {
+	if s.nsqadmin.oidc != nil {
+		session := s.nsqadmin.oidc.session(req)
+		if session == nil {
+			return "", nil
+		}
+		return session.User, session.Groups
+	}
+
+	opts := s.nsqadmin.getOpts()
+	user := req.Header.Get(opts.AclHttpHeader)
+	var groups []string
+	if opts.AclGroupsHttpHeader != "" {
+		for _, g := range strings.Split(req.Header.Get(opts.AclGroupsHttpHeader), ",") {
+			if g = strings.TrimSpace(g); g != "" {
+				groups = append(groups, g)
+			}
+		}
+	}
+	return user, groups
+}
+
+// requestRole returns the role of the user of req on topic
+func (s *httpServer) requestRole(req *http.Request, topic string) role {
+	user, groups := s.requestIdentity(req)
+	return s.nsqadmin.accessControl.roleFor(user, groups, topic)
+}
+
+// isAuthorized returns whether the user of req has at least role r on topic
+// or, with an empty topic, on every topic
+func (s *httpServer) isAuthorized(req *http.Request, r role, topic string) bool {
+	return s.requestRole(req, topic) >= r
+}
@@ -12,8 +12,13 @@ var AppState = Backbone.Model.extend({
             'STATSD_GAUGE_FORMAT': STATSD_GAUGE_FORMAT,
             'STATSD_PREFIX': STATSD_PREFIX,
             'NSQLOOKUPD': NSQLOOKUPD,
+            'CLUSTER': CLUSTER,
+            'CLUSTERS': CLUSTERS,
             'graph_interval': '2h',
             'IS_ADMIN': IS_ADMIN,
+            'HISTORY_ENABLED': HISTORY_ENABLED,
+            'USER': USER,
+            'LOGIN_ENABLED': LOGIN_ENABLED,
             'BASE_PATH': BASE_PATH
         };
     },
@@ -31,13 +36,32 @@ var AppState = Backbone.Model.extend({
         this.set('graph_interval', interval);
     },
 
-    basePath: function(p) {
+    // rootPath is a path outside of any cluster, like static assets
+    rootPath: function(p) {
         // if base path is / then don't prefix
         var bp = this.get('BASE_PATH') === '/' ? '' : this.get('BASE_PATH');
         // remove trailing /, but guarantee at least /
         return (bp + p).replace(/\/$/, '') || '/';
     },
 
+    // basePath is a path within the current cluster, pages opened without a
+    // cluster in their path are for the default one
+    basePath: function(p) {
+        return this.clusterPath(this.get('CLUSTER'), p);
+    },
+
+    clusterPath: function(cluster, p) {
+        if (!cluster) {
+            return this.rootPath(p);
+        }
+        return this.rootPath('/clusters/' + encodeURIComponent(cluster) + p);
+    },
+
+    // currentCluster is the name of the cluster pages are for
+    currentCluster: function() {
+        return this.get('CLUSTER') || this.get('CLUSTERS')[0];
+    },
+
     apiPath: function(p) {
         return this.basePath('/api' + p);
     }
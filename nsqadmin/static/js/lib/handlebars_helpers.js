@@ -107,6 +107,31 @@ var genTargets = function(typ, node, ns1, ns2, key) {
     return targets;
 };
 
+// historyURL is the equivalent of a graphite graph served from the stats
+// sampled by nsqadmin itself when graphite isn't configured
+var historyURL = function(typ, node, ns1, ns2, key, q) {
+    var p = {
+        'type': typ,
+        'node': node ? node : '*',
+        'metric': key
+    };
+    if (typ === 'topic' || typ === 'channel') {
+        p['topic'] = ns1;
+        p['channel'] = ns2;
+    } else if (typ === 'counter') {
+        p['type'] = 'node';
+        p['node'] = '*';
+    } else if (typ === 'e2e') {
+        p['type'] = ns1['channel'] !== '' ? 'channel' : 'topic';
+        p['topic'] = ns1['topic'];
+        p['channel'] = ns1['channel'];
+    }
+    if (AppState.get('graph_interval') !== 'off') {
+        p['from'] = AppState.get('graph_interval');
+    }
+    return AppState.apiPath('/history') + '?' + $.param(_.extend(p, q));
+};
+
 Handlebars.registerHelper('default', function(x, defaultValue) {
     return x ? x : defaultValue;
 });
@@ -232,6 +257,15 @@ Handlebars.registerHelper('nanotohuman', function(n) {
 });
 
 Handlebars.registerHelper('sparkline', function(typ, node, ns1, ns2, key) {
+    if (AppState.get('HISTORY_ENABLED')) {
+        return historyURL(typ, node, ns1, ns2, key, {
+            'format': 'svg',
+            'width': '120',
+            'height': '20',
+            'color': genColorList(typ, key).split(',')[0]
+        });
+    }
+
     var q = {
         'colorList': genColorList(typ, key),
         'height': '20',
@@ -258,6 +292,15 @@ Handlebars.registerHelper('sparkline', function(typ, node, ns1, ns2, key) {
 });
 
 Handlebars.registerHelper('large_graph', function(typ, node, ns1, ns2, key) {
+    if (AppState.get('HISTORY_ENABLED')) {
+        return historyURL(typ, node, ns1, ns2, key, {
+            'format': 'svg',
+            'width': '800',
+            'height': '450',
+            'color': genColorList(typ, key).split(',')[0]
+        });
+    }
+
     var q = {
         'colorList': genColorList(typ, key),
         'height': '450',
@@ -284,12 +327,24 @@ Handlebars.registerHelper('large_graph', function(typ, node, ns1, ns2, key) {
 });
 
 Handlebars.registerHelper('rate', function(typ, node, ns1, ns2) {
+    if (AppState.get('HISTORY_ENABLED')) {
+        return historyURL(typ, node, ns1, ns2, 'message_count', {});
+    }
     return genTargets(typ, node, ns1, ns2, 'message_count')[0];
 });
 
 Handlebars.registerPartial('error', require('../views/error.hbs'));
 Handlebars.registerPartial('warning', require('../views/warning.hbs'));
+Handlebars.registerPartial('metadata', require('../views/metadata.hbs'));
 
 Handlebars.registerHelper('basePath', function(p) {
     return AppState.basePath(p);
 });
+
+Handlebars.registerHelper('rootPath', function(p) {
+    return AppState.rootPath(p);
+});
+
+Handlebars.registerHelper('clusterPath', function(cluster, p) {
+    return AppState.clusterPath(cluster, p);
+});
@@ -0,0 +1,17 @@
+// parse turns "key=value" lines, as edited in the metadata form, into an
+// object, ignoring blank lines
+function parse(text) {
+    var metadata = {};
+    (text || '').split('\n').forEach(function(line) {
+        var i = line.indexOf('=');
+        if (i <= 0) {
+            return;
+        }
+        metadata[line.slice(0, i).trim()] = line.slice(i + 1).trim();
+    });
+    return metadata;
+}
+
+module.exports = {
+    'parse': parse
+};
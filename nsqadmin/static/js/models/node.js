@@ -1,3 +1,5 @@
+var $ = require('jquery');
+
 var AppState = require('../app_state');
 var Backbone = require('backbone');
 
@@ -17,6 +19,10 @@ var Node = Backbone.Model.extend({ //eslint-disable-line no-undef
             'data': JSON.stringify({'topic': topic}),
             'dataType': 'text'
         });
+    },
+
+    untombstoneTopic: function(topic) {
+        return $.post(this.url(), JSON.stringify({'action': 'untombstone', 'topic': topic}));
     }
 });
 
@@ -15,6 +15,10 @@ var Router = Backbone.Router.extend({
         this.route(bp('/lookup'), 'lookup');
         this.route(bp('/nodes(/:node)'), 'nodes');
         this.route(bp('/counter'), 'counter');
+        this.route(bp('/alerts'), 'alerts');
+        this.route(bp('/bulk'), 'bulk');
+        this.route(bp('/audit'), 'audit');
+        this.route(AppState.rootPath('/clusters').substring(1), 'clusters');
         // this.listenTo(this, 'route', function(route, params) {
         //     console.log('Route: %o; params: %o', route, params);
         // });
@@ -52,6 +56,22 @@ var Router = Backbone.Router.extend({
 
     counter: function() {
         Pubsub.trigger('counter:show');
+    },
+
+    alerts: function() {
+        Pubsub.trigger('alerts:show');
+    },
+
+    bulk: function() {
+        Pubsub.trigger('bulk:show');
+    },
+
+    audit: function() {
+        Pubsub.trigger('audit:show');
+    },
+
+    clusters: function() {
+        Pubsub.trigger('clusters:show');
     }
 });
 
@@ -0,0 +1,60 @@
+var $ = require('jquery');
+
+var AppState = require('../app_state');
+var Pubsub = require('../lib/pubsub');
+var BaseView = require('./base');
+
+var AlertsView = BaseView.extend({
+    className: 'alerts container-fluid',
+
+    template: require('./spinner.hbs'),
+
+    events: {
+        'submit .alert-rule-form': 'onSaveRule',
+        'click .delete-rule-link': 'onDeleteRule'
+    },
+
+    initialize: function() {
+        BaseView.prototype.initialize.apply(this, arguments);
+        var isAdmin = arguments[0]['isAdmin'];
+        $.ajax(AppState.apiPath('/alerts'))
+            .done(function(data) {
+                this.template = require('./alerts.hbs');
+                this.render({
+                    'alerts': data['alerts'],
+                    'rules': data['rules'],
+                    'message': data['message'],
+                    'isAdmin': isAdmin
+                });
+            }.bind(this))
+            .fail(this.handleViewError.bind(this))
+            .always(Pubsub.trigger.bind(Pubsub, 'view:ready'));
+    },
+
+    onSaveRule: function(e) {
+        e.preventDefault();
+        e.stopPropagation();
+        var form = e.currentTarget.elements;
+        $.post(AppState.apiPath('/alerts/rules'), JSON.stringify({
+            'name': $(form['name']).val(),
+            'type': $(form['type']).val(),
+            'topic': $(form['topic']).val(),
+            'channel': $(form['channel']).val(),
+            'threshold': parseFloat($(form['threshold']).val()) || 0,
+            'for': $(form['for']).val()
+        }))
+            .done(function() { window.location.reload(true); })
+            .fail(this.handleAJAXError.bind(this));
+    },
+
+    onDeleteRule: function(e) {
+        e.preventDefault();
+        e.stopPropagation();
+        var name = $(e.target).data('name');
+        $.ajax(AppState.apiPath('/alerts/rules/' + encodeURIComponent(name)), {'method': 'DELETE'})
+            .done(function() { window.location.reload(true); })
+            .fail(this.handleAJAXError.bind(this));
+    }
+});
+
+module.exports = AlertsView;
@@ -17,6 +17,10 @@ var LookupView = require('./lookup');
 var NodesView = require('./nodes');
 var NodeView = require('./node');
 var CounterView = require('./counter');
+var AlertsView = require('./alerts');
+var BulkView = require('./bulk');
+var AuditView = require('./audit');
+var ClustersView = require('./clusters');
 
 var Node = require('../models/node'); //eslint-disable-line no-undef
 var Topic = require('../models/topic');
@@ -29,7 +33,8 @@ var AppView = BaseView.extend({
 
     events: {
         'click .link': 'onLinkClick',
-        'click .tombstone-link': 'onTombstoneClick'
+        'click .tombstone-link': 'onTombstoneClick',
+        'click .untombstone-link': 'onUntombstoneClick'
     },
 
     initialize: function() {
@@ -42,10 +47,27 @@ var AppView = BaseView.extend({
         this.listenTo(Pubsub, 'nodes:show', this.showNodes);
         this.listenTo(Pubsub, 'node:show', this.showNode);
         this.listenTo(Pubsub, 'counter:show', this.showCounter);
+        this.listenTo(Pubsub, 'alerts:show', this.showAlerts);
+        this.listenTo(Pubsub, 'bulk:show', this.showBulk);
+        this.listenTo(Pubsub, 'audit:show', this.showAudit);
+        this.listenTo(Pubsub, 'clusters:show', this.showClusters);
 
         this.listenTo(Pubsub, 'view:ready', function() {
             $('.rate').each(function(i, el) {
                 var $el = $(el);
+                if (AppState.get('HISTORY_ENABLED')) {
+                    $.ajax($el.attr('target'))
+                        .done(function(data) {
+                            var points = data['series']['message_count'];
+                            if (!points || !points.length) {
+                                $el.html('N/A');
+                                return;
+                            }
+                            $el.html(points[points.length - 1][1].toFixed(2));
+                        })
+                        .fail(function() { $el.html('ERROR'); });
+                    return;
+                }
                 var interval = AppState.get('STATSD_INTERVAL');
                 var q = {
                     'target': $el.attr('target'),
@@ -137,6 +159,30 @@ var AppView = BaseView.extend({
         });
     },
 
+    showAlerts: function() {
+        this.showView(function() {
+            return new AlertsView({'isAdmin': AppState.get('IS_ADMIN')});
+        });
+    },
+
+    showBulk: function() {
+        this.showView(function() {
+            return new BulkView();
+        });
+    },
+
+    showAudit: function() {
+        this.showView(function() {
+            return new AuditView();
+        });
+    },
+
+    showClusters: function() {
+        this.showView(function() {
+            return new ClustersView();
+        });
+    },
+
     onLinkClick: function(e) {
         if (e.ctrlKey || e.metaKey) {
             // allow ctrl+click to open in a new tab
@@ -164,6 +210,26 @@ var AppView = BaseView.extend({
                 .done(function() { window.location.reload(true); })
                 .fail(this.handleAJAXError.bind(this));
         }.bind(this));
+    },
+
+    onUntombstoneClick: function(e) {
+        e.preventDefault();
+        e.stopPropagation();
+        var nodeName = $(e.target).data('node');
+        var topicName = $(e.target).data('topic');
+        var txt = 'Are you sure you want to <strong>untombstone</strong> <em>' + topicName +
+            '</em> on <em>' + nodeName + '</em>?';
+        bootbox.confirm(txt, function(result) {
+            if (result !== true) {
+                return;
+            }
+            var node = new Node({
+                'name': nodeName
+            });
+            node.untombstoneTopic(topicName)
+                .done(function() { window.location.reload(true); })
+                .fail(this.handleAJAXError.bind(this));
+        }.bind(this));
     }
 });
 
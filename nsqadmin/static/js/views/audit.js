@@ -0,0 +1,53 @@
+var _ = require('underscore');
+var $ = require('jquery');
+
+var AppState = require('../app_state');
+var Pubsub = require('../lib/pubsub');
+var BaseView = require('./base');
+
+var AuditView = BaseView.extend({
+    className: 'audit container-fluid',
+
+    template: require('./spinner.hbs'),
+
+    events: {
+        'submit .audit-form': 'onFilter'
+    },
+
+    initialize: function() {
+        BaseView.prototype.initialize.apply(this, arguments);
+        this.fetch({'user': '', 'topic': '', 'since': ''});
+    },
+
+    fetch: function(filter) {
+        var params = {'user': filter['user'], 'topic': filter['topic']};
+        if (filter['since'] !== '') {
+            params['since'] = Math.floor(Date.now() / 1000) - parseInt(filter['since'], 10);
+        }
+        $.ajax(AppState.apiPath('/audit'), {'data': params})
+            .done(function(data) {
+                this.template = require('./audit.hbs');
+                this.render(_.extend({
+                    'actions': _.map(data['actions'], function(a) {
+                        return _.extend({'time': new Date(a['timestamp'] * 1000).toISOString()}, a);
+                    }),
+                    'message': data['message']
+                }, filter));
+            }.bind(this))
+            .fail(this.handleViewError.bind(this))
+            .always(Pubsub.trigger.bind(Pubsub, 'view:ready'));
+    },
+
+    onFilter: function(e) {
+        e.preventDefault();
+        e.stopPropagation();
+        var form = $(e.currentTarget);
+        this.fetch({
+            'user': form.find('input[name=user]').val(),
+            'topic': form.find('input[name=topic]').val(),
+            'since': form.find('select[name=since]').val()
+        });
+    }
+});
+
+module.exports = AuditView;
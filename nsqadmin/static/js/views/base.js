@@ -50,6 +50,7 @@ var BaseView = Backbone.View.extend({
             'graph_interval': AppState.get('graph_interval'),
             'graph_active': AppState.get('GRAPH_ENABLED') &&
                 AppState.get('graph_interval') !== 'off',
+            'history_enabled': AppState.get('HISTORY_ENABLED'),
             'nsqlookupd': AppState.get('NSQLOOKUPD'),
             'version': AppState.get('VERSION')
         };
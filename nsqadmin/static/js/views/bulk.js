@@ -0,0 +1,64 @@
+var $ = require('jquery');
+
+window.jQuery = $;
+var bootstrap = require('bootstrap'); //eslint-disable-line no-unused-vars
+var bootbox = require('bootbox');
+
+var AppState = require('../app_state');
+var BaseView = require('./base');
+
+var splitNames = function(s) {
+    return s.split(/[\s,]+/).filter(function(name) { return name !== ''; });
+};
+
+var BulkView = BaseView.extend({
+    className: 'bulk container-fluid',
+
+    template: require('./bulk.hbs'),
+
+    events: {
+        'click .bulk-form button': 'onSubmit'
+    },
+
+    onSubmit: function(e) {
+        e.preventDefault();
+        e.stopPropagation();
+        var form = $(e.currentTarget.form);
+        var data = {
+            'action': form.find('select[name=action]').val(),
+            'topics': splitNames(form.find('input[name=topics]').val()),
+            'channels': splitNames(form.find('input[name=channels]').val()),
+            'regex': form.find('input[name=regex]').is(':checked'),
+            'dry_run': $(e.currentTarget).data('dry-run') === true
+        };
+        if (data['dry_run']) {
+            this.submit(data);
+            return;
+        }
+        var txt = 'Are you sure you want to <strong>' + data['action'] +
+            '</strong> everything matching <em>' + data['topics'].join(' ') + '</em>?';
+        bootbox.confirm(txt, function(result) {
+            if (result === true) {
+                this.submit(data);
+            }
+        }.bind(this));
+    },
+
+    submit: function(data) {
+        $.post(AppState.apiPath('/bulk'), JSON.stringify(data))
+            .done(function(resp) {
+                this.render({
+                    'action': data['action'],
+                    'topics': data['topics'].join(' '),
+                    'channels': data['channels'].join(' '),
+                    'regex': data['regex'],
+                    'dry_run': resp['dry_run'],
+                    'results': resp['results'],
+                    'message': resp['message']
+                });
+            }.bind(this))
+            .fail(this.handleAJAXError.bind(this));
+    }
+});
+
+module.exports = BulkView;
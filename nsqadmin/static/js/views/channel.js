@@ -5,6 +5,7 @@ var bootstrap = require('bootstrap'); //eslint-disable-line no-unused-vars
 var bootbox = require('bootbox');
 
 var Pubsub = require('../lib/pubsub');
+var Metadata = require('../lib/metadata');
 var AppState = require('../app_state');
 
 var BaseView = require('./base');
@@ -15,17 +16,24 @@ var ChannelView = BaseView.extend({
     template: require('./spinner.hbs'),
 
     events: {
-        'click .channel-actions button': 'channelAction'
+        'click .channel-actions button': 'channelAction',
+        'submit .metadata-form': 'saveMetadata',
+        'click .client-details-link': 'toggleClientDetails',
+        'click .client-actions button': 'disconnectClient'
     },
 
     initialize: function() {
         BaseView.prototype.initialize.apply(this, arguments);
         this.listenTo(AppState, 'change:graph_interval', this.render);
-        var isAdmin = this.model.get('isAdmin');
         this.model.fetch()
             .done(function(data) {
+                var role = data['role'];
                 this.template = require('./channel.hbs');
-                this.render({'message': data['message'], 'isAdmin': isAdmin});
+                this.render({
+                    'message': data['message'],
+                    'isAdmin': role === 'admin',
+                    'isOperator': role === 'admin' || role === 'operator'
+                });
             }.bind(this))
             .fail(this.handleViewError.bind(this))
             .always(Pubsub.trigger.bind(Pubsub, 'view:ready'));
@@ -56,6 +64,43 @@ var ChannelView = BaseView.extend({
                     .fail(this.handleAJAXError.bind(this));
             }
         }.bind(this));
+    },
+
+    saveMetadata: function(e) {
+        e.preventDefault();
+        e.stopPropagation();
+        var metadata = Metadata.parse($(e.currentTarget).find('textarea[name=metadata]').val());
+        $.post(this.model.url(), JSON.stringify({'action': 'set_metadata', 'metadata': metadata}))
+            .done(function() { window.location.reload(true); })
+            .fail(this.handleAJAXError.bind(this));
+    },
+
+    toggleClientDetails: function(e) {
+        e.preventDefault();
+        e.stopPropagation();
+        this.$($(e.currentTarget).data('target')).toggle();
+    },
+
+    disconnectClient: function(e) {
+        e.preventDefault();
+        e.stopPropagation();
+        var button = $(e.currentTarget);
+        var requeue = button.data('requeue') === true;
+        var txt = 'Are you sure you want to <strong>disconnect</strong> this client' +
+            (requeue ? ' and <strong>requeue</strong> its in-flight messages' : '') + '?';
+        bootbox.confirm(txt, function(result) {
+            if (result !== true) {
+                return;
+            }
+            $.post(this.model.url(), JSON.stringify({
+                'action': 'disconnect',
+                'node': button.data('node'),
+                'client_id': button.data('client-id'),
+                'requeue': requeue
+            }))
+                .done(function() { window.location.reload(true); })
+                .fail(this.handleAJAXError.bind(this));
+        }.bind(this));
     }
 });
 
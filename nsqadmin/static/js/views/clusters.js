@@ -0,0 +1,27 @@
+var $ = require('jquery');
+
+var AppState = require('../app_state');
+var Pubsub = require('../lib/pubsub');
+var BaseView = require('./base');
+
+var ClustersView = BaseView.extend({
+    className: 'clusters container-fluid',
+
+    template: require('./spinner.hbs'),
+
+    initialize: function() {
+        BaseView.prototype.initialize.apply(this, arguments);
+        $.ajax(AppState.rootPath('/api/clusters'))
+            .done(function(data) {
+                this.template = require('./clusters.hbs');
+                this.render({
+                    'clusters': data['clusters'],
+                    'current': AppState.currentCluster()
+                });
+            }.bind(this))
+            .fail(this.handleViewError.bind(this))
+            .always(Pubsub.trigger.bind(Pubsub, 'view:ready'));
+    }
+});
+
+module.exports = ClustersView;
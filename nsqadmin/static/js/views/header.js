@@ -11,7 +11,7 @@ var HeaderView = BaseView.extend({
     template: require('./header.hbs'),
 
     events: {
-        'click .dropdown-menu li': 'onGraphIntervalClick'
+        'click .graph-intervals li': 'onGraphIntervalClick'
     },
 
     initialize: function() {
@@ -22,7 +22,11 @@ var HeaderView = BaseView.extend({
     getRenderCtx: function() {
         return _.extend(BaseView.prototype.getRenderCtx.apply(this, arguments), {
             'graph_intervals': ['1h', '2h', '12h', '24h', '48h', '168h', 'off'],
-            'graph_interval': AppState.get('graph_interval')
+            'graph_interval': AppState.get('graph_interval'),
+            'user': AppState.get('USER'),
+            'login_enabled': AppState.get('LOGIN_ENABLED'),
+            'cluster': AppState.currentCluster(),
+            'clusters': AppState.get('CLUSTERS')
         });
     },
 
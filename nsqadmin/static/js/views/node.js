@@ -14,7 +14,8 @@ var NodeView = BaseView.extend({
         this.model.fetch()
             .done(function(data) {
                 this.template = require('./node.hbs');
-                this.render({'message': data['message']});
+                // roles on topics are not known here, only global admins get actions
+                this.render({'message': data['message'], 'isAdmin': AppState.get('IS_ADMIN')});
             }.bind(this))
             .fail(this.handleViewError.bind(this))
             .always(Pubsub.trigger.bind(Pubsub, 'view:ready'));
@@ -5,6 +5,7 @@ var bootstrap = require('bootstrap'); //eslint-disable-line no-unused-vars
 var bootbox = require('bootbox');
 
 var Pubsub = require('../lib/pubsub');
+var Metadata = require('../lib/metadata');
 var AppState = require('../app_state');
 
 var BaseView = require('./base');
@@ -15,17 +16,23 @@ var TopicView = BaseView.extend({
     template: require('./spinner.hbs'),
 
     events: {
-        'click .topic-actions button': 'topicAction'
+        'click .topic-actions button': 'topicAction',
+        'submit .metadata-form': 'saveMetadata',
+        'submit .publish-form': 'publish'
     },
 
     initialize: function() {
         BaseView.prototype.initialize.apply(this, arguments);
         this.listenTo(AppState, 'change:graph_interval', this.render);
-        var isAdmin = this.model.get('isAdmin');
         this.model.fetch()
             .done(function(data) {
+                var role = data['role'];
                 this.template = require('./topic.hbs');
-                this.render({'message': data['message'], 'isAdmin': isAdmin});
+                this.render({
+                    'message': data['message'],
+                    'isAdmin': role === 'admin',
+                    'isOperator': role === 'admin' || role === 'operator'
+                });
             }.bind(this))
             .fail(this.handleViewError.bind(this))
             .always(Pubsub.trigger.bind(Pubsub, 'view:ready'));
@@ -50,6 +57,35 @@ var TopicView = BaseView.extend({
                     .fail(this.handleAJAXError.bind(this));
             }
         }.bind(this));
+    },
+
+    saveMetadata: function(e) {
+        e.preventDefault();
+        e.stopPropagation();
+        var metadata = Metadata.parse($(e.currentTarget).find('textarea[name=metadata]').val());
+        $.post(this.model.url(), JSON.stringify({'action': 'set_metadata', 'metadata': metadata}))
+            .done(function() { window.location.reload(true); })
+            .fail(this.handleAJAXError.bind(this));
+    },
+
+    publish: function(e) {
+        e.preventDefault();
+        e.stopPropagation();
+        var form = $(e.currentTarget);
+        var body = form.find('textarea[name=messages]').val();
+        var messages = [body];
+        if (form.find('input[name=split]').is(':checked')) {
+            messages = body.split('\n').filter(function(m) { return m !== ''; });
+        }
+        var data = {
+            'action': 'publish',
+            'messages': messages,
+            'node': form.find('select[name=node]').val(),
+            'defer': parseInt(form.find('input[name=defer]').val(), 10) || 0
+        };
+        $.post(this.model.url(), JSON.stringify(data))
+            .done(function() { window.location.reload(true); })
+            .fail(this.handleAJAXError.bind(this));
     }
 });
 
@@ -0,0 +1,96 @@
+package nsqadmin
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/nsqio/nsq/internal/clusterinfo"
+	"github.com/nsqio/nsq/internal/http_api"
+)
+
+// statsLoop fetches the stats of each cluster once for both the alert rules,
+// every --alert-interval, and the history, every --history-interval.
+//
+// It ticks at the shorter of the two intervals and feeds each of them on the
+// first tick after its own interval has elapsed.
+func (n *NSQAdmin) statsLoop() {
+	opts := n.getOpts()
+	httpclient := &http.Client{
+		Transport: http_api.NewDeadlineTransport(opts.HTTPClientConnectTimeout, opts.HTTPClientRequestTimeout),
+	}
+	via, _ := os.Hostname()
+
+	history := n.clusters[0].history != nil
+	interval := opts.AlertInterval
+	if history && opts.HistoryInterval < interval {
+		interval = opts.HistoryInterval
+	}
+	// ticks are allowed to be late by up to half an interval
+	slack := interval / 2
+
+	var lastAlerts, lastHistory time.Time
+	ticker := time.NewTicker(interval)
+	for {
+		select {
+		case <-ticker.C:
+		case <-n.exitChan:
+			goto exit
+		}
+
+		now := time.Now()
+		alertsDue := len(n.alerts.getRules()) > 0 && now.Sub(lastAlerts) >= opts.AlertInterval-slack
+		historyDue := history && now.Sub(lastHistory) >= opts.HistoryInterval-slack
+		if !alertsDue && !historyDue {
+			continue
+		}
+		if alertsDue {
+			lastAlerts = now
+		}
+		if historyDue {
+			lastHistory = now
+		}
+
+		for _, c := range n.clusters {
+			topicStats, channelStats, partial, err := n.fetchStats(c)
+			if err != nil {
+				n.logf(LOG_ERROR, "STATS: %s", err)
+				continue
+			}
+			if historyDue {
+				c.history.record(newHistorySample(topicStats), now)
+			}
+			if alertsDue {
+				n.evaluateAlerts(c, channelStats, partial, now, httpclient, via)
+			}
+		}
+	}
+
+exit:
+	n.logf(LOG_INFO, "STATS: closing")
+	ticker.Stop()
+}
+
+// fetchStats returns the topic and channel stats of every nsqd of a cluster,
+// partial is true when some of them could not be queried
+func (n *NSQAdmin) fetchStats(c *cluster) ([]*clusterinfo.TopicStats, map[string]*clusterinfo.ChannelStats, bool, error) {
+	var partial bool
+	producers, err := c.ci.GetProducers(c.lookupdHTTPAddrs(), c.nsqdHTTPAddrs())
+	if err != nil {
+		if _, ok := err.(clusterinfo.PartialErr); !ok {
+			return nil, nil, false, fmt.Errorf("failed to get producers of cluster %s - %s", c.name, err)
+		}
+		n.logf(LOG_WARN, "STATS: %s", err)
+		partial = true
+	}
+	topicStats, channelStats, err := c.ci.GetNSQDStats(producers, "", "", false)
+	if err != nil {
+		if _, ok := err.(clusterinfo.PartialErr); !ok {
+			return nil, nil, false, fmt.Errorf("failed to get stats of cluster %s - %s", c.name, err)
+		}
+		n.logf(LOG_WARN, "STATS: %s", err)
+		partial = true
+	}
+	return topicStats, channelStats, partial, nil
+}
@@ -0,0 +1,116 @@
+package nsqd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/nsqio/nsq/internal/http_api"
+	"github.com/nsqio/nsq/internal/writers"
+)
+
+const auditTopicName = "__audit"
+
+type auditEntry struct {
+	Timestamp  int64  `json:"timestamp"`
+	Action     string `json:"action"`
+	Target     string `json:"target"`
+	RemoteAddr string `json:"remote_addr"`
+	Identity   string `json:"identity,omitempty"`
+	Result     string `json:"result"`
+}
+
+type auditContextKey struct{}
+
+// auditInfo is attached to the context of audited requests so that
+// checkAuth can record who the caller authenticated as
+type auditInfo struct {
+	identity string
+}
+
+func newAuditWriter(opts *Options) (*writers.RotatingFileWriter, error) {
+	if opts.AuditLogPath == "" {
+		return nil, nil
+	}
+	return writers.NewRotatingFileWriter(opts.AuditLogPath,
+		opts.AuditLogMaxSize, opts.AuditLogMaxBackups)
+}
+
+func (n *NSQD) isAuditEnabled() bool {
+	return n.auditWriter != nil || n.getOpts().AuditTopic
+}
+
+// audit records an administrative action to the audit log file and/or
+// the audit topic
+func (n *NSQD) audit(e auditEntry) {
+	if !n.isAuditEnabled() {
+		return
+	}
+
+	e.Timestamp = time.Now().UnixNano()
+	data, err := json.Marshal(e)
+	if err != nil {
+		n.logf(LOG_ERROR, "AUDIT: failed to marshal entry - %s", err)
+		return
+	}
+
+	if n.auditWriter != nil {
+		_, err = n.auditWriter.Write(append(data, '\n'))
+		if err != nil {
+			n.logf(LOG_ERROR, "AUDIT: failed to write entry - %s", err)
+		}
+	}
+
+	if n.getOpts().AuditTopic {
+		topic := n.GetTopic(auditTopicName)
+		err = topic.PutMessage(NewMessage(topic.GenerateID(), data))
+		if err != nil {
+			n.logf(LOG_ERROR, "AUDIT: failed to publish entry - %s", err)
+		}
+	}
+}
+
+// audited is a decorator that records the outcome of an administrative
+// HTTP request
+func (s *httpServer) audited(action string) http_api.Decorator {
+	return func(f http_api.APIHandler) http_api.APIHandler {
+		return func(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+			if !s.nsqd.isAuditEnabled() {
+				return f(w, req, ps)
+			}
+
+			info := &auditInfo{}
+			req = req.WithContext(context.WithValue(req.Context(), auditContextKey{}, info))
+			data, err := f(w, req, ps)
+
+			result := "OK"
+			if err != nil {
+				result = err.Error()
+			}
+			s.nsqd.audit(auditEntry{
+				Action:     action,
+				Target:     auditTarget(req, ps),
+				RemoteAddr: req.RemoteAddr,
+				Identity:   info.identity,
+				Result:     result,
+			})
+			return data, err
+		}
+	}
+}
+
+// auditTarget describes what a request acted upon, "topic", "topic:channel"
+// or, for config changes, the option name
+func auditTarget(req *http.Request, ps httprouter.Params) string {
+	if opt := ps.ByName("opt"); opt != "" {
+		return opt
+	}
+	q := req.URL.Query()
+	target := q.Get("topic")
+	if channel := q.Get("channel"); channel != "" {
+		target += ":" + channel
+	}
+	return target
+}
@@ -0,0 +1,15 @@
+package nsqd
+
+import (
+	"github.com/nsqio/nsq/internal/auth"
+)
+
+// queryAuth resolves credentials to an auth.State. The local auth policy
+// takes precedence, auth servers are only consulted for clients it does
+// not know about.
+func (n *NSQD) queryAuth(remoteIP string, tlsEnabled bool, commonName string, secret string) (*auth.State, error) {
+	return auth.Query(n.authPolicy.Policy(), n.getOpts().AuthHTTPAddresses,
+		remoteIP, tlsEnabled, commonName, secret,
+		n.getOpts().HTTPClientConnectTimeout,
+		n.getOpts().HTTPClientRequestTimeout)
+}
@@ -357,6 +357,7 @@ func (c *Channel) FinishMessage(clientID int64, id MessageID) error {
 		return err
 	}
 	c.removeFromInFlightPQ(msg)
+	c.traceOutcome(msg, "fin")
 	if c.e2eProcessingLatencyStream != nil {
 		c.e2eProcessingLatencyStream.Insert(msg.Timestamp)
 	}
@@ -376,6 +377,7 @@ func (c *Channel) RequeueMessage(clientID int64, id MessageID, timeout time.Dura
 		return err
 	}
 	c.removeFromInFlightPQ(msg)
+	c.traceOutcome(msg, "req")
 	atomic.AddUint64(&c.requeueCount, 1)
 
 	if timeout == 0 {
@@ -447,6 +449,42 @@ func (c *Channel) RemoveClient(clientID int64) {
 	}
 }
 
+// DisconnectClient closes the connection of a client of the Channel and, with
+// requeue, immediately requeues the messages it has in flight rather than
+// waiting for them to time out (a message sent to it while it disconnects
+// still times out). It returns the number of messages requeued.
+func (c *Channel) DisconnectClient(clientID int64, requeue bool) (int, error) {
+	c.RLock()
+	client, ok := c.clients[clientID]
+	c.RUnlock()
+	if !ok {
+		return 0, errors.New("client does not exist")
+	}
+
+	client.Close()
+	if !requeue {
+		return 0, nil
+	}
+
+	var ids []MessageID
+	c.inFlightMutex.Lock()
+	for id, msg := range c.inFlightMessages {
+		if msg.clientID == clientID {
+			ids = append(ids, id)
+		}
+	}
+	c.inFlightMutex.Unlock()
+
+	requeued := 0
+	for _, id := range ids {
+		// the client may have finished or requeued the message meanwhile
+		if c.RequeueMessage(clientID, id, 0) == nil {
+			requeued++
+		}
+	}
+	return requeued, nil
+}
+
 //channel.StartInFlightTimeout()将消息保存到channel的inFlightMessages和inFlightPQ队列中，这两个缓存是用来处理消费超时的。
 //值得注意的一个小细节是c.addToInFlightPQ(msg)将msg压入最小堆时，将msg在数组的偏移量保存到了msg.index成员中（最小堆底层是数组实现）
 func (c *Channel) StartInFlightTimeout(msg *Message, clientID int64, timeout time.Duration) error {
@@ -454,8 +492,10 @@ func (c *Channel) StartInFlightTimeout(msg *Message, clientID int64, timeout tim
 	msg.clientID = clientID
 	msg.deliveryTS = now
 	msg.pri = now.Add(timeout).UnixNano()
+	c.traceDeliver(msg, clientID)
 	err := c.pushInFlightMessage(msg)
 	if err != nil {
+		msg.deliverySpan = nil
 		return err
 	}
 	c.addToInFlightPQ(msg)
@@ -608,6 +648,7 @@ func (c *Channel) processInFlightQueue(t int64) bool {
 			goto exit
 		}
 		atomic.AddUint64(&c.timeoutCount, 1)
+		c.traceOutcome(msg, "timeout")
 		c.RLock()
 		client, ok := c.clients[msg.clientID]
 		c.RUnlock()
@@ -54,6 +54,7 @@ type PubCount struct {
 }
 
 type ClientV2Stats struct {
+	ID              int64  `json:"id"`
 	ClientID        string `json:"client_id"`
 	Hostname        string `json:"hostname"`
 	Version         string `json:"version"`
@@ -229,6 +230,14 @@ func (c *clientV2) String() string {
 	return c.RemoteAddr().String()
 }
 
+// LogFields implements lg.Fielder
+func (c *clientV2) LogFields() map[string]interface{} {
+	return map[string]interface{}{
+		"client_id":   c.ID,
+		"remote_addr": c.String(),
+	}
+}
+
 func (c *clientV2) Type() int {
 	c.metaLock.RLock()
 	hasPublished := len(c.pubCounts) > 0
@@ -309,6 +318,7 @@ func (c *clientV2) Stats(topicName string) ClientStats {
 	c.metaLock.RUnlock()
 	stats := ClientV2Stats{
 		Version:         "V2",
+		ID:              c.ID,
 		RemoteAddress:   c.RemoteAddr().String(),
 		ClientID:        clientID,
 		Hostname:        hostname,
@@ -652,10 +662,7 @@ func (c *clientV2) QueryAuthd() error {
 		}
 	}
 
-	authState, err := auth.QueryAnyAuthd(c.nsqd.getOpts().AuthHTTPAddresses,
-		remoteIP, tlsEnabled, commonName, c.AuthSecret,
-		c.nsqd.getOpts().HTTPClientConnectTimeout,
-		c.nsqd.getOpts().HTTPClientRequestTimeout)
+	authState, err := c.nsqd.queryAuth(remoteIP, tlsEnabled, commonName, c.AuthSecret)
 	if err != nil {
 		return err
 	}
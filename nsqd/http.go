@@ -62,41 +62,47 @@ func newHTTPServer(nsqd *NSQD, tlsEnabled bool, tlsRequired bool) *httpServer {
 	router.Handle("GET", "/stats", http_api.Decorate(s.doStats, log, http_api.V1))
 
 	// only v1
-	router.Handle("POST", "/topic/create", http_api.Decorate(s.doCreateTopic, log, http_api.V1))
-	router.Handle("POST", "/topic/delete", http_api.Decorate(s.doDeleteTopic, log, http_api.V1))
-	router.Handle("POST", "/topic/empty", http_api.Decorate(s.doEmptyTopic, log, http_api.V1))
-	router.Handle("POST", "/topic/pause", http_api.Decorate(s.doPauseTopic, log, http_api.V1))
-	router.Handle("POST", "/topic/unpause", http_api.Decorate(s.doPauseTopic, log, http_api.V1))
-	router.Handle("POST", "/channel/create", http_api.Decorate(s.doCreateChannel, log, http_api.V1))
-	router.Handle("POST", "/channel/delete", http_api.Decorate(s.doDeleteChannel, log, http_api.V1))
-	router.Handle("POST", "/channel/empty", http_api.Decorate(s.doEmptyChannel, log, http_api.V1))
-	router.Handle("POST", "/channel/pause", http_api.Decorate(s.doPauseChannel, log, http_api.V1))
-	router.Handle("POST", "/channel/unpause", http_api.Decorate(s.doPauseChannel, log, http_api.V1))
+	router.Handle("POST", "/topic/create", http_api.Decorate(s.doCreateTopic, log, s.audited("create_topic"), http_api.V1))
+	router.Handle("POST", "/topic/delete", http_api.Decorate(s.doDeleteTopic, log, s.audited("delete_topic"), http_api.V1))
+	router.Handle("POST", "/topic/empty", http_api.Decorate(s.doEmptyTopic, log, s.audited("empty_topic"), http_api.V1))
+	router.Handle("POST", "/topic/pause", http_api.Decorate(s.doPauseTopic, log, s.audited("pause_topic"), http_api.V1))
+	router.Handle("POST", "/topic/unpause", http_api.Decorate(s.doPauseTopic, log, s.audited("unpause_topic"), http_api.V1))
+	router.Handle("POST", "/channel/create", http_api.Decorate(s.doCreateChannel, log, s.audited("create_channel"), http_api.V1))
+	router.Handle("POST", "/channel/delete", http_api.Decorate(s.doDeleteChannel, log, s.audited("delete_channel"), http_api.V1))
+	router.Handle("POST", "/channel/empty", http_api.Decorate(s.doEmptyChannel, log, s.audited("empty_channel"), http_api.V1))
+	router.Handle("POST", "/channel/pause", http_api.Decorate(s.doPauseChannel, log, s.audited("pause_channel"), http_api.V1))
+	router.Handle("POST", "/channel/unpause", http_api.Decorate(s.doPauseChannel, log, s.audited("unpause_channel"), http_api.V1))
+	router.Handle("POST", "/client/disconnect", http_api.Decorate(s.doDisconnectClient, log, s.audited("disconnect_client"), http_api.V1))
 	router.Handle("GET", "/config/:opt", http_api.Decorate(s.doConfig, log, http_api.V1))
-	router.Handle("PUT", "/config/:opt", http_api.Decorate(s.doConfig, log, http_api.V1))
+	router.Handle("PUT", "/config/:opt", http_api.Decorate(s.doConfig, log, s.audited("set_config"), http_api.V1))
 
 	// debug
-	router.HandlerFunc("GET", "/debug/pprof/", pprof.Index)
-	router.HandlerFunc("GET", "/debug/pprof/cmdline", pprof.Cmdline)
-	router.HandlerFunc("GET", "/debug/pprof/symbol", pprof.Symbol)
-	router.HandlerFunc("POST", "/debug/pprof/symbol", pprof.Symbol)
-	router.HandlerFunc("GET", "/debug/pprof/profile", pprof.Profile)
-	router.Handler("GET", "/debug/pprof/heap", pprof.Handler("heap"))
-	router.Handler("GET", "/debug/pprof/goroutine", pprof.Handler("goroutine"))
-	router.Handler("GET", "/debug/pprof/block", pprof.Handler("block"))
-	router.Handle("PUT", "/debug/setblockrate", http_api.Decorate(setBlockRateHandler, log, http_api.PlainText))
-	router.Handler("GET", "/debug/pprof/threadcreate", pprof.Handler("threadcreate"))
+	router.Handler("GET", "/debug/pprof/", s.adminOnly(http.HandlerFunc(pprof.Index)))
+	router.Handler("GET", "/debug/pprof/cmdline", s.adminOnly(http.HandlerFunc(pprof.Cmdline)))
+	router.Handler("GET", "/debug/pprof/symbol", s.adminOnly(http.HandlerFunc(pprof.Symbol)))
+	router.Handler("POST", "/debug/pprof/symbol", s.adminOnly(http.HandlerFunc(pprof.Symbol)))
+	router.Handler("GET", "/debug/pprof/profile", s.adminOnly(http.HandlerFunc(pprof.Profile)))
+	router.Handler("GET", "/debug/pprof/heap", s.adminOnly(pprof.Handler("heap")))
+	router.Handler("GET", "/debug/pprof/goroutine", s.adminOnly(pprof.Handler("goroutine")))
+	router.Handler("GET", "/debug/pprof/block", s.adminOnly(pprof.Handler("block")))
+	router.Handle("PUT", "/debug/setblockrate", http_api.Decorate(s.setBlockRateHandler, log, http_api.PlainText))
+	router.Handler("GET", "/debug/pprof/threadcreate", s.adminOnly(pprof.Handler("threadcreate")))
 
 	return s
 }
 
-func setBlockRateHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+func (s *httpServer) setBlockRateHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	err := s.checkAuth(req, "admin", "", "")
+	if err != nil {
+		return nil, err
+	}
+
 	rate, err := strconv.Atoi(req.FormValue("rate"))
 	if err != nil {
 		return nil, http_api.Err{http.StatusBadRequest, fmt.Sprintf("invalid block rate : %s", err.Error())}
 	}
 	runtime.SetBlockProfileRate(rate)
-	return nil, nil
+	return "OK", nil
 }
 
 func (s *httpServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
@@ -121,6 +127,11 @@ func (s *httpServer) pingHandler(w http.ResponseWriter, req *http.Request, ps ht
 }
 
 func (s *httpServer) doInfo(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	_, err := s.authenticate(req)
+	if err != nil {
+		return nil, err
+	}
+
 	hostname, err := os.Hostname()
 	if err != nil {
 		return nil, http_api.Err{500, err.Error()}
@@ -142,7 +153,7 @@ func (s *httpServer) doInfo(w http.ResponseWriter, req *http.Request, ps httprou
 	}, nil
 }
 
-func (s *httpServer) getExistingTopicFromQuery(req *http.Request) (*http_api.ReqParams, *Topic, string, error) {
+func (s *httpServer) getExistingTopicFromQuery(req *http.Request, permission string) (*http_api.ReqParams, *Topic, string, error) {
 	reqParams, err := http_api.NewReqParams(req)
 	if err != nil {
 		s.nsqd.logf(LOG_ERROR, "failed to parse request params - %s", err)
@@ -154,6 +165,11 @@ func (s *httpServer) getExistingTopicFromQuery(req *http.Request) (*http_api.Req
 		return nil, nil, "", http_api.Err{400, err.Error()}
 	}
 
+	err = s.checkAuth(req, permission, topicName, channelName)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
 	topic, err := s.nsqd.GetExistingTopic(topicName)
 	if err != nil {
 		return nil, nil, "", http_api.Err{404, "TOPIC_NOT_FOUND"}
@@ -179,6 +195,11 @@ func (s *httpServer) getTopicFromQuery(req *http.Request) (url.Values, *Topic, e
 		return nil, nil, http_api.Err{400, "INVALID_TOPIC"}
 	}
 
+	err = s.checkAuth(req, "publish", topicName, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
 	return reqParams, s.nsqd.GetTopic(topicName), nil
 }
 
@@ -224,7 +245,14 @@ func (s *httpServer) doPUB(w http.ResponseWriter, req *http.Request, ps httprout
 
 	msg := NewMessage(topic.GenerateID(), body)
 	msg.deferred = deferred
+	spans := s.nsqd.tracePublish(topic.name, traceparentFromRequest(req), msg)
+	if int64(len(msg.Body)) > s.nsqd.getOpts().MaxMsgSize {
+		err = http_api.Err{413, "MSG_TOO_BIG"}
+		finishSpans(spans, err)
+		return nil, err
+	}
 	err = topic.PutMessage(msg)
+	finishSpans(spans, err)
 	if err != nil {
 		return nil, http_api.Err{503, "EXITING"}
 	}
@@ -302,7 +330,16 @@ func (s *httpServer) doMPUB(w http.ResponseWriter, req *http.Request, ps httprou
 		}
 	}
 
+	spans := s.nsqd.tracePublish(topic.name, traceparentFromRequest(req), msgs...)
+	for _, msg := range msgs {
+		if int64(len(msg.Body)) > s.nsqd.getOpts().MaxMsgSize {
+			err = http_api.Err{413, "MSG_TOO_BIG"}
+			finishSpans(spans, err)
+			return nil, err
+		}
+	}
 	err = topic.PutMessages(msgs)
+	finishSpans(spans, err)
 	if err != nil {
 		return nil, http_api.Err{503, "EXITING"}
 	}
@@ -331,6 +368,11 @@ func (s *httpServer) doEmptyTopic(w http.ResponseWriter, req *http.Request, ps h
 		return nil, http_api.Err{400, "INVALID_TOPIC"}
 	}
 
+	err = s.checkAuth(req, "admin", topicName, "")
+	if err != nil {
+		return nil, err
+	}
+
 	topic, err := s.nsqd.GetExistingTopic(topicName)
 	if err != nil {
 		return nil, http_api.Err{404, "TOPIC_NOT_FOUND"}
@@ -356,6 +398,11 @@ func (s *httpServer) doDeleteTopic(w http.ResponseWriter, req *http.Request, ps
 		return nil, http_api.Err{400, "MISSING_ARG_TOPIC"}
 	}
 
+	err = s.checkAuth(req, "admin", topicName, "")
+	if err != nil {
+		return nil, err
+	}
+
 	err = s.nsqd.DeleteExistingTopic(topicName)
 	if err != nil {
 		return nil, http_api.Err{404, "TOPIC_NOT_FOUND"}
@@ -376,6 +423,11 @@ func (s *httpServer) doPauseTopic(w http.ResponseWriter, req *http.Request, ps h
 		return nil, http_api.Err{400, "MISSING_ARG_TOPIC"}
 	}
 
+	err = s.checkAuth(req, "admin", topicName, "")
+	if err != nil {
+		return nil, err
+	}
+
 	topic, err := s.nsqd.GetExistingTopic(topicName)
 	if err != nil {
 		return nil, http_api.Err{404, "TOPIC_NOT_FOUND"}
@@ -400,7 +452,7 @@ func (s *httpServer) doPauseTopic(w http.ResponseWriter, req *http.Request, ps h
 }
 
 func (s *httpServer) doCreateChannel(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
-	_, topic, channelName, err := s.getExistingTopicFromQuery(req)
+	_, topic, channelName, err := s.getExistingTopicFromQuery(req, "subscribe")
 	if err != nil {
 		return nil, err
 	}
@@ -409,7 +461,7 @@ func (s *httpServer) doCreateChannel(w http.ResponseWriter, req *http.Request, p
 }
 
 func (s *httpServer) doEmptyChannel(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
-	_, topic, channelName, err := s.getExistingTopicFromQuery(req)
+	_, topic, channelName, err := s.getExistingTopicFromQuery(req, "admin")
 	if err != nil {
 		return nil, err
 	}
@@ -428,7 +480,7 @@ func (s *httpServer) doEmptyChannel(w http.ResponseWriter, req *http.Request, ps
 }
 
 func (s *httpServer) doDeleteChannel(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
-	_, topic, channelName, err := s.getExistingTopicFromQuery(req)
+	_, topic, channelName, err := s.getExistingTopicFromQuery(req, "admin")
 	if err != nil {
 		return nil, err
 	}
@@ -441,8 +493,39 @@ func (s *httpServer) doDeleteChannel(w http.ResponseWriter, req *http.Request, p
 	return nil, nil
 }
 
+func (s *httpServer) doDisconnectClient(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	reqParams, topic, channelName, err := s.getExistingTopicFromQuery(req, "admin")
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := topic.GetExistingChannel(channelName)
+	if err != nil {
+		return nil, http_api.Err{404, "CHANNEL_NOT_FOUND"}
+	}
+
+	idStr, err := reqParams.Get("id")
+	if err != nil {
+		return nil, http_api.Err{400, "MISSING_ARG_ID"}
+	}
+	clientID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_ID"}
+	}
+	requeue, _ := reqParams.Get("requeue")
+
+	requeued, err := channel.DisconnectClient(clientID, requeue == "true")
+	if err != nil {
+		return nil, http_api.Err{404, "CLIENT_NOT_FOUND"}
+	}
+
+	return struct {
+		Requeued int `json:"requeued"`
+	}{requeued}, nil
+}
+
 func (s *httpServer) doPauseChannel(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
-	_, topic, channelName, err := s.getExistingTopicFromQuery(req)
+	_, topic, channelName, err := s.getExistingTopicFromQuery(req, "admin")
 	if err != nil {
 		return nil, err
 	}
@@ -492,7 +575,19 @@ func (s *httpServer) doStats(w http.ResponseWriter, req *http.Request, ps httpro
 		includeMem = true
 	}
 
+	state, err := s.authenticate(req)
+	if err != nil {
+		return nil, err
+	}
+
 	stats := s.nsqd.GetStats(topicName, channelName, includeClients)
+	if state != nil {
+		stats, err = filterStats(stats, state)
+		if err != nil {
+			s.nsqd.logf(LOG_INFO, "HTTP: [%s] %q denied stats", req.RemoteAddr, state.Identity)
+			return nil, err
+		}
+	}
 	health := s.nsqd.GetHealth()
 	startTime := s.nsqd.GetStartTime()
 	uptime := time.Since(startTime)
@@ -600,6 +695,11 @@ func (s *httpServer) printStats(stats Stats, ms *memStats, health string, startT
 func (s *httpServer) doConfig(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
 	opt := ps.ByName("opt")
 
+	err := s.checkAuth(req, "admin", "", "")
+	if err != nil {
+		return nil, err
+	}
+
 	if req.Method == "PUT" {
 		// add 1 so that it's greater than our max when we test for it
 		// (LimitReader returns a "fake" EOF)
@@ -0,0 +1,102 @@
+package nsqd
+
+import (
+	"net/http"
+
+	"github.com/nsqio/nsq/internal/auth"
+	"github.com/nsqio/nsq/internal/http_api"
+)
+
+// checkAuth enforces permission on topic/channel for the credentials
+// presented with req, it is a no-op when auth is not enabled.
+//
+// channel is empty for topic level actions, both are empty for node level actions
+func (s *httpServer) checkAuth(req *http.Request, permission, topic, channel string) error {
+	state, err := s.authenticate(req)
+	if err != nil || state == nil {
+		return err
+	}
+
+	if !state.IsPermitted(permission, topic, channel) {
+		s.nsqd.logf(LOG_INFO, "HTTP: [%s] %q denied %s on %q %q",
+			req.RemoteAddr, state.Identity, permission, topic, channel)
+		return http_api.Err{403, "UNAUTHORIZED"}
+	}
+	return nil
+}
+
+// authenticate resolves the credentials presented with req, the returned
+// state is nil when auth is not enabled
+func (s *httpServer) authenticate(req *http.Request) (*auth.State, error) {
+	if !s.nsqd.IsAuthEnabled() {
+		return nil, nil
+	}
+
+	state, err := s.nsqd.httpAuth.Authenticate(req)
+	if err == auth.ErrNoCredentials {
+		return nil, http_api.Err{403, "AUTH_REQUIRED"}
+	}
+	if err != nil {
+		// we don't want to leak errors contacting the auth server to untrusted clients
+		s.nsqd.logf(LOG_WARN, "HTTP: [%s] AUTH failed %s", req.RemoteAddr, err)
+		return nil, http_api.Err{403, "AUTH_FAILED"}
+	}
+
+	if info, ok := req.Context().Value(auditContextKey{}).(*auditInfo); ok {
+		info.identity = state.Identity
+	}
+	return state, nil
+}
+
+// filterStats drops the topics and channels of stats that state may neither
+// subscribe to nor administer, producers are only kept for node level admins.
+//
+// It fails for states without any subscribe or admin authorization.
+func filterStats(stats Stats, state *auth.State) (Stats, error) {
+	var permitted bool
+	for _, a := range state.Authorizations {
+		if a.HasPermission("subscribe") || a.HasPermission("admin") {
+			permitted = true
+			break
+		}
+	}
+	if !permitted {
+		return Stats{}, http_api.Err{403, "UNAUTHORIZED"}
+	}
+
+	visible := func(topic, channel string) bool {
+		return state.IsPermitted("subscribe", topic, channel) ||
+			state.IsPermitted("admin", topic, channel)
+	}
+
+	var filtered Stats
+	for _, t := range stats.Topics {
+		var channels []ChannelStats
+		for _, c := range t.Channels {
+			if visible(t.TopicName, c.ChannelName) {
+				channels = append(channels, c)
+			}
+		}
+		if len(channels) == 0 && !visible(t.TopicName, "") {
+			continue
+		}
+		t.Channels = channels
+		filtered.Topics = append(filtered.Topics, t)
+	}
+	if state.IsPermitted("admin", "", "") {
+		filtered.Producers = stats.Producers
+	}
+	return filtered, nil
+}
+
+// adminOnly guards handlers that are not decorated by http_api
+func (s *httpServer) adminOnly(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		err := s.checkAuth(req, "admin", "", "")
+		if err != nil {
+			http_api.RespondV1(w, err.(http_api.Err).Code, err)
+			return
+		}
+		h.ServeHTTP(w, req)
+	})
+}
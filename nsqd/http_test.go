@@ -660,6 +660,171 @@ func TestHTTPconfig(t *testing.T) {
 	test.Equal(t, 400, resp.StatusCode)
 }
 
+func TestHTTPAuth(t *testing.T) {
+	policyFile, err := ioutil.TempFile("", "nsq-auth-policy-")
+	test.Nil(t, err)
+	defer os.Remove(policyFile.Name())
+	_, err = policyFile.WriteString(`{"identities":[
+		{"secret":"pubsecret", "identity":"publisher",
+		 "authorizations":[{"topic":"test", "channels":[".*"], "permissions":["publish"]}]},
+		{"secret":"subsecret", "identity":"subscriber",
+		 "authorizations":[{"topic":"test", "channels":["^ch$"], "permissions":["subscribe"]}]},
+		{"secret":"adminsecret", "identity":"operator",
+		 "authorizations":[{"topic":".*", "channels":[".*"], "permissions":["admin"]}]}
+	]}`)
+	test.Nil(t, err)
+	policyFile.Close()
+
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.AuthPolicyFile = policyFile.Name()
+	opts.AuthPolicyRefresh = 50 * time.Millisecond
+	_, httpAddr, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	do := func(method, endpoint, secret string, body string) (int, string) {
+		req, err := http.NewRequest(method, fmt.Sprintf("http://%s%s", httpAddr, endpoint),
+			bytes.NewBufferString(body))
+		test.Nil(t, err)
+		if secret != "" {
+			req.Header.Set("Authorization", "Bearer "+secret)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		test.Nil(t, err)
+		defer resp.Body.Close()
+		respBody, _ := ioutil.ReadAll(resp.Body)
+		return resp.StatusCode, string(respBody)
+	}
+
+	code, body := do("POST", "/pub?topic=test", "", "msg")
+	test.Equal(t, 403, code)
+	test.Equal(t, `{"message":"AUTH_REQUIRED"}`, body)
+
+	code, body = do("POST", "/pub?topic=test", "badsecret", "msg")
+	test.Equal(t, 403, code)
+	test.Equal(t, `{"message":"AUTH_FAILED"}`, body)
+
+	code, body = do("POST", "/pub?topic=other", "pubsecret", "msg")
+	test.Equal(t, 403, code)
+	test.Equal(t, `{"message":"UNAUTHORIZED"}`, body)
+	_, err = nsqd.GetExistingTopic("other")
+	test.NotNil(t, err)
+
+	code, body = do("POST", "/pub?topic=test", "pubsecret", "msg")
+	test.Equal(t, 200, code)
+	test.Equal(t, "OK", body)
+
+	code, _ = do("POST", "/topic/empty?topic=test", "pubsecret", "")
+	test.Equal(t, 403, code)
+	code, _ = do("PUT", "/config/log_level", "pubsecret", "debug")
+	test.Equal(t, 403, code)
+
+	code, _ = do("POST", "/topic/empty?topic=test", "adminsecret", "")
+	test.Equal(t, 200, code)
+	code, _ = do("PUT", "/config/log_level", "adminsecret", "debug")
+	test.Equal(t, 200, code)
+
+	code, _ = do("GET", "/info", "", "")
+	test.Equal(t, 403, code)
+	code, _ = do("GET", "/info", "pubsecret", "")
+	test.Equal(t, 200, code)
+
+	// stats only show what the caller may subscribe to or administer
+	nsqd.GetTopic("test").GetChannel("ch")
+	nsqd.GetTopic("test").GetChannel("other_ch")
+	nsqd.GetTopic("other").GetChannel("ch")
+	stats := func(secret string) []TopicStats {
+		code, body := do("GET", "/stats?format=json", secret, "")
+		test.Equal(t, 200, code)
+		var ret struct {
+			Topics []TopicStats `json:"topics"`
+		}
+		test.Nil(t, json.Unmarshal([]byte(body), &ret))
+		return ret.Topics
+	}
+	code, _ = do("GET", "/stats", "", "")
+	test.Equal(t, 403, code)
+	code, _ = do("GET", "/stats", "pubsecret", "")
+	test.Equal(t, 403, code)
+	topics := stats("subsecret")
+	test.Equal(t, 1, len(topics))
+	test.Equal(t, "test", topics[0].TopicName)
+	test.Equal(t, 1, len(topics[0].Channels))
+	test.Equal(t, "ch", topics[0].Channels[0].ChannelName)
+	test.Equal(t, 2, len(stats("adminsecret")))
+
+	code, _ = do("GET", "/debug/pprof/", "", "")
+	test.Equal(t, 403, code)
+	code, _ = do("PUT", "/debug/setblockrate?rate=0", "pubsecret", "")
+	test.Equal(t, 403, code)
+	code, _ = do("GET", "/debug/pprof/", "adminsecret", "")
+	test.Equal(t, 200, code)
+	code, _ = do("PUT", "/debug/setblockrate?rate=0", "adminsecret", "")
+	test.Equal(t, 200, code)
+
+	// a secret removed from the policy stops working once it is reloaded,
+	// even though its cached state hasn't expired
+	err = ioutil.WriteFile(policyFile.Name(), []byte(`{"identities":[
+		{"secret":"adminsecret", "identity":"operator",
+		 "authorizations":[{"topic":".*", "channels":[".*"], "permissions":["admin"]}]}
+	]}`), 0600)
+	test.Nil(t, err)
+	for i := 0; i < 100; i++ {
+		code, _ = do("POST", "/pub?topic=test", "pubsecret", "msg")
+		if code == 403 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	test.Equal(t, 403, code)
+}
+
+func TestHTTPAudit(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.AuditTopic = true
+	auditDir, err := ioutil.TempDir("", "nsq-audit-")
+	test.Nil(t, err)
+	defer os.RemoveAll(auditDir)
+	opts.AuditLogPath = auditDir + "/audit.log"
+	_, httpAddr, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	url := fmt.Sprintf("http://%s/topic/create?topic=test_audit", httpAddr)
+	resp, err := http.Post(url, "application/json", nil)
+	test.Nil(t, err)
+	resp.Body.Close()
+
+	url = fmt.Sprintf("http://%s/channel/delete?topic=test_audit&channel=missing", httpAddr)
+	resp, err = http.Post(url, "application/json", nil)
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 404, resp.StatusCode)
+
+	data, err := ioutil.ReadFile(opts.AuditLogPath)
+	test.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	test.Equal(t, 2, len(lines))
+
+	var e auditEntry
+	test.Nil(t, json.Unmarshal([]byte(lines[0]), &e))
+	test.Equal(t, "create_topic", e.Action)
+	test.Equal(t, "test_audit", e.Target)
+	test.Equal(t, "OK", e.Result)
+	test.NotNil(t, e.RemoteAddr)
+
+	test.Nil(t, json.Unmarshal([]byte(lines[1]), &e))
+	test.Equal(t, "delete_channel", e.Action)
+	test.Equal(t, "test_audit:missing", e.Target)
+	test.Equal(t, "CHANNEL_NOT_FOUND", e.Result)
+
+	topic, err := nsqd.GetExistingTopic(auditTopicName)
+	test.Nil(t, err)
+	test.Equal(t, int64(2), topic.Depth())
+}
+
 func TestHTTPerrors(t *testing.T) {
 	opts := NewOptions()
 	opts.Logger = test.NewTestLogger(t)
@@ -874,6 +1039,64 @@ func TestEmptyChannel(t *testing.T) {
 	test.Equal(t, []byte(""), body)
 }
 
+func TestDisconnectClient(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	tcpAddr, httpAddr, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topicName := "test_http_disconnect_client" + strconv.Itoa(int(time.Now().Unix()))
+	topic := nsqd.GetTopic(topicName)
+	channel := topic.GetChannel("ch")
+
+	conn, err := mustConnectNSQD(tcpAddr)
+	test.Nil(t, err)
+	defer conn.Close()
+	identify(t, conn, nil, frameTypeResponse)
+	sub(t, conn, topicName, "ch")
+	// with all of its RDY count in flight the client isn't sent the requeued
+	// messages while it disconnects
+	_, err = nsq.Ready(3).WriteTo(conn)
+	test.Nil(t, err)
+
+	for i := 0; i < 3; i++ {
+		topic.PutMessage(NewMessage(topic.GenerateID(), []byte("test body")))
+	}
+	inFlight := func() int {
+		channel.inFlightMutex.Lock()
+		defer channel.inFlightMutex.Unlock()
+		return len(channel.inFlightMessages)
+	}
+	for i := 0; i < 100 && inFlight() < 3; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	test.Equal(t, 3, inFlight())
+
+	stats := nsqd.GetStats(topicName, "ch", true)
+	clientID := stats.Topics[0].Channels[0].Clients[0].(ClientV2Stats).ID
+
+	url := fmt.Sprintf("http://%s/client/disconnect?topic=%s&channel=ch&id=%d&requeue=true", httpAddr, topicName, clientID+1)
+	resp, err := http.Post(url, "application/json", nil)
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 404, resp.StatusCode)
+
+	url = fmt.Sprintf("http://%s/client/disconnect?topic=%s&channel=ch&id=%d&requeue=true", httpAddr, topicName, clientID)
+	resp, err = http.Post(url, "application/json", nil)
+	test.Nil(t, err)
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	test.Equal(t, 200, resp.StatusCode)
+	test.Equal(t, `{"requeued":3}`, string(body))
+
+	test.Equal(t, 0, inFlight())
+	test.Equal(t, int64(3), channel.Depth())
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	_, err = ioutil.ReadAll(conn)
+	test.Nil(t, err)
+}
+
 func TestInfo(t *testing.T) {
 	opts := NewOptions()
 	opts.Logger = test.NewTestLogger(t)
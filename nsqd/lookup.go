@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/nsqio/go-nsq"
+	"github.com/nsqio/nsq/internal/disk"
 	"github.com/nsqio/nsq/internal/version"
 )
 
@@ -20,6 +21,12 @@ func connectCallback(n *NSQD, hostname string) func(*lookupPeer) {
 		ci["http_port"] = n.getOpts().BroadcastHTTPPort
 		ci["hostname"] = hostname
 		ci["broadcast_address"] = n.getOpts().BroadcastAddress
+		if opts := n.getOpts(); opts.Zone != "" || opts.Region != "" || opts.Rack != "" || len(opts.Tags) > 0 {
+			ci["zone"] = opts.Zone
+			ci["region"] = opts.Region
+			ci["rack"] = opts.Rack
+			ci["tags"] = opts.Tags
+		}
 
 		cmd, err := nsq.Identify(ci)
 		if err != nil {
@@ -72,6 +79,64 @@ func connectCallback(n *NSQD, hostname string) func(*lookupPeer) {
 				return
 			}
 		}
+
+		if lp.Info.LoadHints {
+			n.sendLoadHints(lp, n.loadCommand())
+		}
+	}
+}
+
+// loadHints describes how loaded this nsqd is, it is sent to nsqlookupd
+// that support it so that lookups can prefer less loaded nodes
+type loadHints struct {
+	Health      string           `json:"health"`
+	Depth       int64            `json:"depth"`
+	TopicDepths map[string]int64 `json:"topic_depths"`
+	Clients     int64            `json:"clients"`
+	DiskUsage   float64          `json:"disk_usage"`
+}
+
+func (n *NSQD) loadHints() *loadHints {
+	hints := &loadHints{
+		Health:      n.GetHealth(),
+		TopicDepths: make(map[string]int64),
+	}
+	for _, t := range n.GetStats("", "", false).Topics {
+		depth := t.Depth
+		for _, c := range t.Channels {
+			depth += c.Depth
+			hints.Clients += int64(c.ClientCount)
+		}
+		hints.TopicDepths[t.TopicName] = depth
+		hints.Depth += depth
+	}
+	usage, err := disk.Usage(n.getOpts().DataPath)
+	if err != nil {
+		n.logf(LOG_DEBUG, "LOOKUP: failed to get disk usage - %s", err)
+	}
+	hints.DiskUsage = usage
+	return hints
+}
+
+// loadCommand returns a LOAD command carrying the current load hints,
+// nil if they could not be encoded
+func (n *NSQD) loadCommand() *nsq.Command {
+	body, err := json.Marshal(n.loadHints())
+	if err != nil {
+		n.logf(LOG_ERROR, "LOOKUP: failed to marshal load hints - %s", err)
+		return nil
+	}
+	return &nsq.Command{Name: []byte("LOAD"), Body: body}
+}
+
+func (n *NSQD) sendLoadHints(lp *lookupPeer, cmd *nsq.Command) {
+	if cmd == nil {
+		return
+	}
+	n.logf(LOG_DEBUG, "LOOKUPD(%s): sending load hints", lp)
+	_, err := lp.Command(cmd)
+	if err != nil {
+		n.logf(LOG_ERROR, "LOOKUPD(%s): LOAD - %s", lp, err)
 	}
 }
 
@@ -108,12 +173,20 @@ func (n *NSQD) lookupLoop() {
 		select {
 		case <-ticker:
 			// send a heartbeat and read a response (read detects closed conns)
+			var loadCmd *nsq.Command
 			for _, lookupPeer := range lookupPeers {
 				n.logf(LOG_DEBUG, "LOOKUPD(%s): sending heartbeat", lookupPeer)
 				cmd := nsq.Ping()
 				_, err := lookupPeer.Command(cmd)
 				if err != nil {
 					n.logf(LOG_ERROR, "LOOKUPD(%s): %s - %s", lookupPeer, cmd, err)
+					continue
+				}
+				if lookupPeer.Info.LoadHints {
+					if loadCmd == nil {
+						loadCmd = n.loadCommand()
+					}
+					n.sendLoadHints(lookupPeer, loadCmd)
 				}
 			}
 		case val := <-n.notifyChan:
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"time"
+
+	"github.com/nsqio/nsq/internal/tracing"
 )
 
 const (
@@ -26,6 +28,10 @@ type Message struct {
 	pri        int64
 	index      int
 	deferred   time.Duration
+
+	// for tracing
+	trace        tracing.SpanContext
+	deliverySpan *tracing.Span
 }
 
 func NewMessage(id MessageID, body []byte) *Message {
@@ -85,6 +91,7 @@ func decodeMessage(b []byte) (*Message, error) {
 	msg.Attempts = binary.BigEndian.Uint16(b[8:10])
 	copy(msg.ID[:], b[10:10+MsgIDLength])
 	msg.Body = b[10+MsgIDLength:]
+	msg.trace, _, _ = tracing.SplitBody(msg.Body)
 
 	return &msg, nil
 }
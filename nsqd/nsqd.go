@@ -8,7 +8,6 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"math/rand"
 	"net"
 	"os"
@@ -18,13 +17,17 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/nsqio/nsq/internal/auth"
 	"github.com/nsqio/nsq/internal/clusterinfo"
 	"github.com/nsqio/nsq/internal/dirlock"
 	"github.com/nsqio/nsq/internal/http_api"
+	"github.com/nsqio/nsq/internal/lg"
 	"github.com/nsqio/nsq/internal/protocol"
 	"github.com/nsqio/nsq/internal/statsd"
+	"github.com/nsqio/nsq/internal/tracing"
 	"github.com/nsqio/nsq/internal/util"
 	"github.com/nsqio/nsq/internal/version"
+	"github.com/nsqio/nsq/internal/writers"
 )
 
 const (
@@ -57,6 +60,10 @@ type NSQD struct {
 	topicMap map[string]*Topic //保存所有的topic
 
 	lookupPeers atomic.Value
+	authPolicy  *auth.PolicyWatcher
+	httpAuth    *auth.HTTPAuth
+	auditWriter *writers.RotatingFileWriter
+	tracer      *tracing.Tracer
 
 	tcpServer     *tcpServer   //tcp
 	tcpListener   net.Listener //tcp监听
@@ -83,7 +90,11 @@ func New(opts *Options) (*NSQD, error) {
 		dataPath = cwd
 	}
 	if opts.Logger == nil {
-		opts.Logger = log.New(os.Stderr, opts.LogPrefix, log.Ldate|log.Ltime|log.Lmicroseconds)
+		logger, err := lg.NewLogger(opts.LogFormat, os.Stderr, opts.LogPrefix, "nsqd")
+		if err != nil {
+			return nil, err
+		}
+		opts.Logger = logger
 	}
 
 	n := &NSQD{
@@ -99,6 +110,7 @@ func New(opts *Options) (*NSQD, error) {
 	n.ci = clusterinfo.New(n.logf, httpcli)
 
 	n.lookupPeers.Store([]*lookupPeer{})
+	n.httpAuth = auth.NewHTTPAuth(n.queryAuth)
 
 	n.swapOpts(opts)
 	n.errValue.Store(errStore{})
@@ -129,6 +141,21 @@ func New(opts *Options) (*NSQD, error) {
 	}
 	n.tlsConfig = tlsConfig
 
+	if opts.AuthPolicyFile != "" {
+		// credentials resolved by the previous policy may have been revoked
+		n.authPolicy, err = auth.NewPolicyWatcher(opts.AuthPolicyFile, n.logf, n.httpAuth.Clear)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load auth policy - %s", err)
+		}
+	}
+
+	n.tracer = newTracer(n, opts)
+
+	n.auditWriter, err = newAuditWriter(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log - %s", err)
+	}
+
 	for _, v := range opts.E2EProcessingLatencyPercentiles {
 		if v <= 0 || v > 1 {
 			return nil, fmt.Errorf("invalid E2E processing latency percentile: %v", v)
@@ -272,6 +299,12 @@ func (n *NSQD) Main() error {
 	if n.getOpts().StatsdAddress != "" {
 		n.waitGroup.Wrap(n.statsdLoop)
 	}
+	if n.authPolicy != nil {
+		n.waitGroup.Wrap(func() { n.authPolicy.Run(n.getOpts().AuthPolicyRefresh, n.exitChan) })
+	}
+	if n.tracer != nil {
+		n.waitGroup.Wrap(func() { n.tracer.Run(n.exitChan) })
+	}
 
 	err := <-exitCh
 	return err
@@ -450,6 +483,9 @@ func (n *NSQD) Exit() {
 	n.logf(LOG_INFO, "NSQ: stopping subsystems")
 	close(n.exitChan)
 	n.waitGroup.Wait()
+	if n.auditWriter != nil {
+		n.auditWriter.Close()
+	}
 	n.dl.Unlock()
 	n.logf(LOG_INFO, "NSQ: bye")
 	n.ctxCancel()
@@ -767,7 +803,7 @@ func buildTLSConfig(opts *Options) (*tls.Config, error) {
 }
 
 func (n *NSQD) IsAuthEnabled() bool {
-	return len(n.getOpts().AuthHTTPAddresses) != 0
+	return len(n.getOpts().AuthHTTPAddresses) != 0 || n.getOpts().AuthPolicyFile != ""
 }
 
 // Context returns a context that will be canceled when nsqd initiates the shutdown
@@ -17,6 +17,7 @@ type Options struct {
 	ID        int64       `flag:"node-id" cfg:"id"`
 	LogLevel  lg.LogLevel `flag:"log-level"`
 	LogPrefix string      `flag:"log-prefix"`
+	LogFormat string      `flag:"log-format"`
 	Logger    Logger
 
 	TCPAddress               string        `flag:"tcp-address"`
@@ -25,11 +26,27 @@ type Options struct {
 	BroadcastAddress         string        `flag:"broadcast-address"`
 	BroadcastTCPPort         int           `flag:"broadcast-tcp-port"`
 	BroadcastHTTPPort        int           `flag:"broadcast-http-port"`
+	Zone                     string        `flag:"zone"`
+	Region                   string        `flag:"region"`
+	Rack                     string        `flag:"rack"`
+	Tags                     []string      `flag:"tag" cfg:"tags"`
 	NSQLookupdTCPAddresses   []string      `flag:"lookupd-tcp-address" cfg:"nsqlookupd_tcp_addresses"`
 	AuthHTTPAddresses        []string      `flag:"auth-http-address" cfg:"auth_http_addresses"`
+	AuthPolicyFile           string        `flag:"auth-policy-file"`
+	AuthPolicyRefresh        time.Duration `flag:"auth-policy-refresh"`
 	HTTPClientConnectTimeout time.Duration `flag:"http-client-connect-timeout" cfg:"http_client_connect_timeout"`
 	HTTPClientRequestTimeout time.Duration `flag:"http-client-request-timeout" cfg:"http_client_request_timeout"`
 
+	// tracing options
+	OTLPEndpoint       string `flag:"otlp-endpoint"`
+	TraceMessageHeader bool   `flag:"trace-message-header"`
+
+	// audit options
+	AuditLogPath       string `flag:"audit-log-path"`
+	AuditLogMaxSize    int64  `flag:"audit-log-max-size"`
+	AuditLogMaxBackups int    `flag:"audit-log-max-backups"`
+	AuditTopic         bool   `flag:"audit-topic"`
+
 	// diskqueue options
 	DataPath        string        `flag:"data-path"`
 	MemQueueSize    int64         `flag:"mem-queue-size"`
@@ -99,6 +116,7 @@ func NewOptions() *Options {
 	return &Options{
 		ID:        defaultID,
 		LogPrefix: "[nsqd] ",
+		LogFormat: "text",
 		LogLevel:  lg.INFO,
 
 		TCPAddress:        "0.0.0.0:4150",
@@ -107,13 +125,18 @@ func NewOptions() *Options {
 		BroadcastAddress:  hostname,
 		BroadcastTCPPort:  0,
 		BroadcastHTTPPort: 0,
+		Tags:              make([]string, 0),
 
 		NSQLookupdTCPAddresses: make([]string, 0),
 		AuthHTTPAddresses:      make([]string, 0),
+		AuthPolicyRefresh:      10 * time.Second,
 
 		HTTPClientConnectTimeout: 2 * time.Second,
 		HTTPClientRequestTimeout: 5 * time.Second,
 
+		AuditLogMaxSize:    100 * 1024 * 1024,
+		AuditLogMaxBackups: 5,
+
 		MemQueueSize:    10000,
 		MaxBytesPerFile: 100 * 1024 * 1024,
 		SyncEvery:       2500,
@@ -14,6 +14,7 @@ import (
 	"unsafe"
 
 	"github.com/nsqio/nsq/internal/protocol"
+	"github.com/nsqio/nsq/internal/tracing"
 	"github.com/nsqio/nsq/internal/version"
 )
 
@@ -849,7 +850,9 @@ func (p *protocolV2) PUB(client *clientV2, params [][]byte) ([]byte, error) {
 
 	topic := p.nsqd.GetTopic(topicName)
 	msg := NewMessage(topic.GenerateID(), messageBody)
+	spans := p.nsqd.tracePublish(topicName, tracing.SpanContext{}, msg)
 	err = topic.PutMessage(msg)
+	finishSpans(spans, err)
 	if err != nil {
 		return nil, protocol.NewFatalClientErr(err, "E_PUB_FAILED", "PUB failed "+err.Error())
 	}
@@ -902,7 +905,9 @@ func (p *protocolV2) MPUB(client *clientV2, params [][]byte) ([]byte, error) {
 	// if we've made it this far we've validated all the input,
 	// the only possible error is that the topic is exiting during
 	// this next call (and no messages will be queued in that case)
+	spans := p.nsqd.tracePublish(topicName, tracing.SpanContext{}, messages...)
 	err = topic.PutMessages(messages)
+	finishSpans(spans, err)
 	if err != nil {
 		return nil, protocol.NewFatalClientErr(err, "E_MPUB_FAILED", "MPUB failed "+err.Error())
 	}
@@ -966,7 +971,9 @@ func (p *protocolV2) DPUB(client *clientV2, params [][]byte) ([]byte, error) {
 	topic := p.nsqd.GetTopic(topicName)
 	msg := NewMessage(topic.GenerateID(), messageBody)
 	msg.deferred = timeoutDuration
+	spans := p.nsqd.tracePublish(topicName, tracing.SpanContext{}, msg)
 	err = topic.PutMessage(msg)
+	finishSpans(spans, err)
 	if err != nil {
 		return nil, protocol.NewFatalClientErr(err, "E_DPUB_FAILED", "DPUB failed "+err.Error())
 	}
@@ -1576,6 +1576,85 @@ func runAuthTest(t *testing.T, authResponse string, authSecret string, authError
 	}
 }
 
+func TestClientAuthPolicy(t *testing.T) {
+	policy := `{"ttl":10, "identities":[
+		{"secret":"pubsecret", "identity":"publisher",
+		 "authorizations":[{"topic":"test", "channels":[".*"], "permissions":["publish"]}]},
+		{"secret":"subsecret", "identity":"consumer",
+		 "authorizations":[{"topic":"test", "channels":["ch"], "permissions":["subscribe"]}]}
+	]}`
+
+	authd := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		test.Equal(t, "authdsecret", r.Form.Get("secret"))
+		fmt.Fprint(w, `{"ttl":10, "identity":"remote", "authorizations":
+			[{"topic":"test", "channels":[".*"], "permissions":["subscribe"]}]}`)
+	}))
+	defer authd.Close()
+	addr, err := url.Parse(authd.URL)
+	test.Nil(t, err)
+
+	policyFile, err := ioutil.TempFile("", "nsq-auth-policy-")
+	test.Nil(t, err)
+	defer os.Remove(policyFile.Name())
+	_, err = policyFile.WriteString(policy)
+	test.Nil(t, err)
+	policyFile.Close()
+
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.LogLevel = LOG_DEBUG
+	opts.AuthPolicyFile = policyFile.Name()
+	opts.AuthPolicyRefresh = 50 * time.Millisecond
+	tcpAddr, _, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	// an identity from the policy file
+	conn, err := mustConnectNSQD(tcpAddr)
+	test.Nil(t, err)
+	identify(t, conn, nil, frameTypeResponse)
+	authCmd(t, conn, "subsecret", `{"identity":"consumer","identity_url":"","permission_count":1}`)
+	subFail(t, conn, "test", "other")
+	conn.Close()
+
+	// an unknown secret is rejected when there is no auth server to fall back to
+	conn, err = mustConnectNSQD(tcpAddr)
+	test.Nil(t, err)
+	identify(t, conn, nil, frameTypeResponse)
+	authCmd(t, conn, "authdsecret", "")
+	readValidate(t, conn, frameTypeError, "E_AUTH_FAILED AUTH failed")
+	conn.Close()
+
+	// secrets missing from the policy are resolved by the auth server
+	newOpts := *opts
+	newOpts.AuthHTTPAddresses = []string{addr.Host}
+	nsqd.swapOpts(&newOpts)
+	conn, err = mustConnectNSQD(tcpAddr)
+	test.Nil(t, err)
+	identify(t, conn, nil, frameTypeResponse)
+	authCmd(t, conn, "authdsecret", `{"identity":"remote","identity_url":"","permission_count":1}`)
+	sub(t, conn, "test", "other")
+	conn.Close()
+
+	// the policy is reloaded when the file changes
+	err = ioutil.WriteFile(policyFile.Name(), []byte(`{"identities":[{"secret":"newsecret",
+		"authorizations":[{"topic":".*", "channels":[".*"], "permissions":["subscribe"]}]}]}`), 0600)
+	test.Nil(t, err)
+	for i := 0; i < 40; i++ {
+		if nsqd.authPolicy.Policy().Query(false, "", "newsecret") != nil {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	conn, err = mustConnectNSQD(tcpAddr)
+	test.Nil(t, err)
+	identify(t, conn, nil, frameTypeResponse)
+	authCmd(t, conn, "newsecret", `{"identity":"","identity_url":"","permission_count":1}`)
+	sub(t, conn, "test", "other")
+	conn.Close()
+}
+
 func TestIOLoopReturnsClientErrWhenSendFails(t *testing.T) {
 	fakeConn := test.NewFakeNetConn()
 	fakeConn.WriteFunc = func(b []byte) (int, error) {
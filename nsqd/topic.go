@@ -331,15 +331,18 @@ func (t *Topic) messagePump() {
 				chanMsg = NewMessage(msg.ID, msg.Body)
 				chanMsg.Timestamp = msg.Timestamp
 				chanMsg.deferred = msg.deferred
+				chanMsg.trace = msg.trace
 			}
 			//表示延时消息，此时不是直接调用putMessage()方法写入channel，而是调用channel.PutMessageDeferred
 			//消息被写入了延时队列Channel.deferredMessages和Channel.deferredPQ
 			if chanMsg.deferred != 0 {
 				channel.PutMessageDeferred(chanMsg, chanMsg.deferred)
+				channel.traceEnqueue(chanMsg, nil)
 				continue
 			}
 			// 将消息发送给Topic，和Topic接收消息相似，channel把消息写入其中的memoryMsgChan或者backendMsgChan
 			err := channel.PutMessage(chanMsg)
+			channel.traceEnqueue(chanMsg, err)
 			if err != nil {
 				t.nsqd.logf(LOG_ERROR,
 					"TOPIC(%s) ERROR: failed to put msg(%s) to channel(%s) - %s",
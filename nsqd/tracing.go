@@ -0,0 +1,120 @@
+package nsqd
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/nsqio/nsq/internal/http_api"
+	"github.com/nsqio/nsq/internal/tracing"
+)
+
+func newTracer(n *NSQD, opts *Options) *tracing.Tracer {
+	if opts.OTLPEndpoint == "" {
+		return nil
+	}
+	client := &http.Client{
+		Transport: http_api.NewDeadlineTransport(opts.HTTPClientConnectTimeout, opts.HTTPClientRequestTimeout),
+		Timeout:   opts.HTTPClientRequestTimeout,
+	}
+	return tracing.NewTracer(tracing.NewExporter(opts.OTLPEndpoint, "nsqd", client, n.logf))
+}
+
+// tracePublish records the trace context of each message, from the message
+// header in its body or, failing that, from parent (eg. the traceparent HTTP
+// header).
+//
+// Message bodies are left as published unless --trace-message-header is set,
+// in which case parent is written into the body as a message header so that
+// it reaches consumers and survives the disk queue, and with a tracer the
+// message header is pointed at the publish span so that consumers continue
+// the trace from nsqd. Callers must check the size of the resulting bodies.
+func (n *NSQD) tracePublish(topicName string, parent tracing.SpanContext, msgs ...*Message) []*tracing.Span {
+	writeHeader := n.getOpts().TraceMessageHeader
+	var spans []*tracing.Span
+	for _, msg := range msgs {
+		sc, body, ok := tracing.SplitBody(msg.Body)
+		if !ok && parent.IsValid() {
+			sc = parent
+			if writeHeader {
+				msg.Body = tracing.JoinBody(parent, msg.Body)
+				ok = true
+			}
+		}
+		msg.trace = sc
+
+		span := n.tracer.StartSpan("nsqd.publish", tracing.SpanKindServer, msg.trace)
+		if span == nil {
+			continue
+		}
+		span.SetAttribute("messaging.system", "nsq")
+		span.SetAttribute("messaging.destination.name", topicName)
+		span.SetAttribute("messaging.message.id", string(msg.ID[:]))
+		span.SetAttribute("messaging.message.body.size", len(msg.Body))
+		msg.trace = span.SpanContext()
+		if writeHeader && ok {
+			msg.Body = tracing.JoinBody(msg.trace, body)
+		}
+		spans = append(spans, span)
+	}
+	return spans
+}
+
+// traceparentFromRequest returns the trace context from the traceparent
+// header of req, the zero value when it is missing or invalid
+func traceparentFromRequest(req *http.Request) tracing.SpanContext {
+	sc, _ := tracing.ParseTraceparent(req.Header.Get(tracing.TraceparentHeader))
+	return sc
+}
+
+func finishSpans(spans []*tracing.Span, err error) {
+	for _, span := range spans {
+		if err != nil {
+			span.SetError(err.Error())
+		}
+		span.Finish()
+	}
+}
+
+// traceEnqueue records a message being queued to a channel
+func (c *Channel) traceEnqueue(msg *Message, err error) {
+	span := c.nsqd.tracer.StartSpan("nsqd.enqueue", tracing.SpanKindInternal, msg.trace)
+	if span == nil {
+		return
+	}
+	span.SetAttribute("messaging.destination.name", c.topicName)
+	span.SetAttribute("messaging.nsq.channel", c.name)
+	span.SetAttribute("messaging.message.id", string(msg.ID[:]))
+	if msg.deferred != 0 {
+		span.SetAttribute("messaging.nsq.deferred_ms", int64(msg.deferred/1e6))
+	}
+	finishSpans([]*tracing.Span{span}, err)
+}
+
+// traceDeliver starts the span covering a message's time in flight to a client
+func (c *Channel) traceDeliver(msg *Message, clientID int64) {
+	span := c.nsqd.tracer.StartSpan("nsqd.deliver", tracing.SpanKindProducer, msg.trace)
+	if span == nil {
+		return
+	}
+	span.SetAttribute("messaging.destination.name", c.topicName)
+	span.SetAttribute("messaging.nsq.channel", c.name)
+	span.SetAttribute("messaging.message.id", string(msg.ID[:]))
+	span.SetAttribute("messaging.nsq.client_id", clientID)
+	span.SetAttribute("messaging.nsq.attempts", msg.Attempts)
+	msg.deliverySpan = span
+}
+
+// traceOutcome ends the delivery span with how the message left flight,
+// one of "fin", "req" or "timeout"
+func (c *Channel) traceOutcome(msg *Message, outcome string) {
+	span := msg.deliverySpan
+	if span == nil {
+		return
+	}
+	msg.deliverySpan = nil
+	span.SetAttribute("messaging.nsq.outcome", outcome)
+	if outcome == "timeout" {
+		span.SetError(fmt.Sprintf("message timed out after %d attempts", msg.Attempts))
+	}
+	span.Finish()
+}
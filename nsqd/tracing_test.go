@@ -0,0 +1,239 @@
+package nsqd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nsqio/go-nsq"
+	"github.com/nsqio/nsq/internal/test"
+	"github.com/nsqio/nsq/internal/tracing"
+)
+
+type collectedSpan struct {
+	TraceID      string `json:"traceId"`
+	ParentSpanID string `json:"parentSpanId"`
+	Name         string `json:"name"`
+	Attributes   []struct {
+		Key   string `json:"key"`
+		Value struct {
+			StringValue string `json:"stringValue"`
+		} `json:"value"`
+	} `json:"attributes"`
+}
+
+func (s collectedSpan) attr(key string) string {
+	for _, a := range s.Attributes {
+		if a.Key == key {
+			return a.Value.StringValue
+		}
+	}
+	return ""
+}
+
+func readMessage(t *testing.T, conn io.Reader) *Message {
+	resp, err := nsq.ReadResponse(conn)
+	test.Nil(t, err)
+	frameType, data, err := nsq.UnpackResponse(resp)
+	test.Nil(t, err)
+	test.Equal(t, frameTypeMessage, frameType)
+	msg, err := decodeMessage(data)
+	test.Nil(t, err)
+	return msg
+}
+
+func TestTracing(t *testing.T) {
+	var mtx sync.Mutex
+	var spans []collectedSpan
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		test.Equal(t, "/v1/traces", r.URL.Path)
+		var body struct {
+			ResourceSpans []struct {
+				ScopeSpans []struct {
+					Spans []collectedSpan `json:"spans"`
+				} `json:"scopeSpans"`
+			} `json:"resourceSpans"`
+		}
+		test.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		mtx.Lock()
+		for _, rs := range body.ResourceSpans {
+			for _, ss := range rs.ScopeSpans {
+				spans = append(spans, ss.Spans...)
+			}
+		}
+		mtx.Unlock()
+	}))
+	defer collector.Close()
+
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.OTLPEndpoint = collector.URL + "/v1/traces"
+	opts.TraceMessageHeader = true
+	tcpAddr, httpAddr, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	parent, err := tracing.ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	test.Nil(t, err)
+	topicName := "test_tracing" + fmt.Sprint(time.Now().Unix())
+
+	conn, err := mustConnectNSQD(tcpAddr)
+	test.Nil(t, err)
+	defer conn.Close()
+	identify(t, conn, nil, frameTypeResponse)
+	sub(t, conn, topicName, "ch")
+
+	// a message header in the body (TCP) and the traceparent HTTP header
+	// should both parent the spans nsqd emits
+	url := fmt.Sprintf("http://%s/pub?topic=%s", httpAddr, topicName)
+	req, err := http.NewRequest("POST", url, bytes.NewBufferString("untraced body"))
+	test.Nil(t, err)
+	req.Header.Set("traceparent", parent.Traceparent())
+	resp, err := http.DefaultClient.Do(req)
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 200, resp.StatusCode)
+
+	_, err = nsq.Ready(1).WriteTo(conn)
+	test.Nil(t, err)
+	msgOut := readMessage(t, conn)
+	sc, body, ok := tracing.SplitBody(msgOut.Body)
+	test.Equal(t, true, ok)
+	test.Equal(t, []byte("untraced body"), body)
+	test.Equal(t, parent.TraceID, sc.TraceID)
+	test.NotEqual(t, parent.SpanID, sc.SpanID)
+	_, err = nsq.Finish(nsq.MessageID(msgOut.ID)).WriteTo(conn)
+	test.Nil(t, err)
+
+	pubConn, err := mustConnectNSQD(tcpAddr)
+	test.Nil(t, err)
+	defer pubConn.Close()
+	identify(t, pubConn, nil, frameTypeResponse)
+	_, err = nsq.Publish(topicName, tracing.JoinBody(parent, []byte("traced body"))).WriteTo(pubConn)
+	test.Nil(t, err)
+	readValidate(t, pubConn, frameTypeResponse, "OK")
+
+	_, err = nsq.Ready(1).WriteTo(conn)
+	test.Nil(t, err)
+	msgOut = readMessage(t, conn)
+	sc, body, ok = tracing.SplitBody(msgOut.Body)
+	test.Equal(t, true, ok)
+	test.Equal(t, []byte("traced body"), body)
+	test.Equal(t, parent.TraceID, sc.TraceID)
+	test.NotEqual(t, parent.SpanID, sc.SpanID)
+	_, err = nsq.Requeue(nsq.MessageID(msgOut.ID), 0).WriteTo(conn)
+	test.Nil(t, err)
+	time.Sleep(50 * time.Millisecond)
+
+	// exiting flushes the exporter
+	nsqd.Exit()
+
+	mtx.Lock()
+	defer mtx.Unlock()
+	traceID := hex.EncodeToString(parent.TraceID[:])
+	names := make(map[string]int)
+	outcomes := make(map[string]int)
+	for _, s := range spans {
+		test.Equal(t, traceID, s.TraceID)
+		names[s.Name]++
+		if s.Name == "nsqd.deliver" {
+			outcomes[s.attr("messaging.nsq.outcome")]++
+		}
+	}
+	test.Equal(t, 2, names["nsqd.publish"])
+	test.Equal(t, 2, names["nsqd.enqueue"])
+	test.Equal(t, 1, outcomes["fin"])
+	test.Equal(t, 1, outcomes["req"])
+}
+
+func TestTracingWithoutExporter(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.TraceMessageHeader = true
+	// messages go through the disk queue
+	opts.MemQueueSize = 0
+	tcpAddr, httpAddr, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	parent, err := tracing.ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	test.Nil(t, err)
+	topicName := "test_tracing_no_exporter" + fmt.Sprint(time.Now().Unix())
+
+	conn, err := mustConnectNSQD(tcpAddr)
+	test.Nil(t, err)
+	defer conn.Close()
+	identify(t, conn, nil, frameTypeResponse)
+	sub(t, conn, topicName, "ch")
+
+	// the traceparent HTTP header is carried to consumers in the body
+	for _, endpoint := range []string{"pub", "mpub"} {
+		url := fmt.Sprintf("http://%s/%s?topic=%s", httpAddr, endpoint, topicName)
+		req, err := http.NewRequest("POST", url, bytes.NewBufferString("body"))
+		test.Nil(t, err)
+		req.Header.Set("traceparent", parent.Traceparent())
+		resp, err := http.DefaultClient.Do(req)
+		test.Nil(t, err)
+		resp.Body.Close()
+		test.Equal(t, 200, resp.StatusCode)
+
+		_, err = nsq.Ready(1).WriteTo(conn)
+		test.Nil(t, err)
+		msgOut := readMessage(t, conn)
+		sc, body, ok := tracing.SplitBody(msgOut.Body)
+		test.Equal(t, true, ok)
+		test.Equal(t, []byte("body"), body)
+		test.Equal(t, parent, sc)
+		_, err = nsq.Finish(nsq.MessageID(msgOut.ID)).WriteTo(conn)
+		test.Nil(t, err)
+	}
+	// the message header counts towards --max-msg-size
+	url := fmt.Sprintf("http://%s/pub?topic=%s", httpAddr, topicName)
+	req, err := http.NewRequest("POST", url, bytes.NewReader(make([]byte, opts.MaxMsgSize)))
+	test.Nil(t, err)
+	req.Header.Set("traceparent", parent.Traceparent())
+	resp, err := http.DefaultClient.Do(req)
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 413, resp.StatusCode)
+}
+
+func TestTracingBodyUnchanged(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	tcpAddr, httpAddr, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topicName := "test_tracing_body_unchanged" + fmt.Sprint(time.Now().Unix())
+
+	conn, err := mustConnectNSQD(tcpAddr)
+	test.Nil(t, err)
+	defer conn.Close()
+	identify(t, conn, nil, frameTypeResponse)
+	sub(t, conn, topicName, "ch")
+
+	// without --trace-message-header the traceparent HTTP header doesn't
+	// change what consumers receive
+	url := fmt.Sprintf("http://%s/pub?topic=%s", httpAddr, topicName)
+	req, err := http.NewRequest("POST", url, bytes.NewBufferString("body"))
+	test.Nil(t, err)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	resp, err := http.DefaultClient.Do(req)
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 200, resp.StatusCode)
+
+	_, err = nsq.Ready(1).WriteTo(conn)
+	test.Nil(t, err)
+	msgOut := readMessage(t, conn)
+	test.Equal(t, []byte("body"), msgOut.Body)
+}
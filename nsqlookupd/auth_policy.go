@@ -0,0 +1,15 @@
+package nsqlookupd
+
+import (
+	"github.com/nsqio/nsq/internal/auth"
+)
+
+// queryAuth resolves credentials to an auth.State. The local auth policy
+// takes precedence, auth servers are only consulted for clients it does
+// not know about.
+func (l *NSQLookupd) queryAuth(remoteIP string, tlsEnabled bool, commonName string, secret string) (*auth.State, error) {
+	return auth.Query(l.authPolicy.Policy(), l.opts.AuthHTTPAddresses,
+		remoteIP, tlsEnabled, commonName, secret,
+		l.opts.HTTPClientConnectTimeout,
+		l.opts.HTTPClientRequestTimeout)
+}
@@ -0,0 +1,207 @@
+package nsqlookupd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/nsqio/nsq/internal/http_api"
+)
+
+const (
+	// eventPartitions is the number of ordered queues of each event sink,
+	// events with the same key always go through the same queue
+	eventPartitions = 16
+	// maxEventRetryBackoff caps the exponential backoff between attempts
+	maxEventRetryBackoff = time.Minute
+)
+
+// registrationEvent is emitted for every change to the RegistrationDB, its
+// Type is the matching journal operation
+type registrationEvent struct {
+	Sequence     int64        `json:"sequence"`
+	Type         string       `json:"type"`
+	Timestamp    int64        `json:"timestamp"`
+	Source       string       `json:"source"`
+	Registration Registration `json:"registration"`
+	Producer     *PeerInfo    `json:"producer,omitempty"`
+}
+
+// key is what events are ordered by, all the events of a topic (and its
+// channels) are delivered in order
+func (e *registrationEvent) key() string {
+	return e.Registration.Key
+}
+
+type queuedEvent struct {
+	key  string
+	body []byte
+}
+
+// eventSink delivers events to a webhook or an nsqd topic, retrying each one
+// until it succeeds or runs out of attempts before moving on to the next
+// event of its partition
+type eventSink struct {
+	name       string
+	deliver    func(body []byte) error
+	partitions []chan queuedEvent
+}
+
+// eventEmitter fans registration events out to the configured sinks.
+//
+// Sequences start from the startup time in microseconds so that they keep
+// increasing across restarts.
+type eventEmitter struct {
+	nsqlookupd *NSQLookupd
+	source     string
+	sequence   int64
+	sinks      []*eventSink
+}
+
+func newEventEmitter(l *NSQLookupd) (*eventEmitter, error) {
+	opts := l.opts
+	e := &eventEmitter{
+		nsqlookupd: l,
+		source:     fmt.Sprintf("%s:%d", opts.BroadcastAddress, l.RealHTTPAddr().Port),
+		sequence:   time.Now().UnixNano() / int64(time.Microsecond),
+	}
+
+	httpclient := &http.Client{
+		Transport: http_api.NewDeadlineTransport(opts.HTTPClientConnectTimeout, opts.HTTPClientRequestTimeout),
+	}
+	post := func(endpoint string, body []byte) error {
+		resp, err := httpclient.Post(endpoint, "application/json", bytes.NewBuffer(body))
+		if err != nil {
+			return err
+		}
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return fmt.Errorf("got response %s", resp.Status)
+		}
+		return nil
+	}
+
+	for _, u := range opts.EventWebhookURLs {
+		if _, err := url.ParseRequestURI(u); err != nil {
+			return nil, fmt.Errorf("invalid --event-webhook-url %q - %s", u, err)
+		}
+		endpoint := u
+		e.addSink(fmt.Sprintf("webhook(%s)", endpoint), func(body []byte) error {
+			return post(endpoint, body)
+		})
+	}
+	if opts.EventNSQDHTTPAddress != "" {
+		endpoint := fmt.Sprintf("http://%s/pub?topic=%s",
+			opts.EventNSQDHTTPAddress, url.QueryEscape(opts.EventTopic))
+		e.addSink(fmt.Sprintf("nsqd(%s/%s)", opts.EventNSQDHTTPAddress, opts.EventTopic), func(body []byte) error {
+			return post(endpoint, body)
+		})
+	}
+	return e, nil
+}
+
+func (e *eventEmitter) addSink(name string, deliver func([]byte) error) {
+	s := &eventSink{
+		name:       name,
+		deliver:    deliver,
+		partitions: make([]chan queuedEvent, eventPartitions),
+	}
+	size := e.nsqlookupd.opts.EventQueueSize / eventPartitions
+	if size < 1 {
+		size = 1
+	}
+	for i := range s.partitions {
+		s.partitions[i] = make(chan queuedEvent, size)
+	}
+	e.sinks = append(e.sinks, s)
+}
+
+// emit queues an event for every sink, callers hold the RegistrationDB write
+// lock so sequences follow the order changes are applied. It never blocks,
+// events are dropped when a queue is full.
+func (e *eventEmitter) emit(typ string, k Registration, peerInfo *PeerInfo) {
+	e.sequence++
+	ev := &registrationEvent{
+		Sequence:     e.sequence,
+		Type:         typ,
+		Timestamp:    time.Now().UnixNano(),
+		Source:       e.source,
+		Registration: k,
+		Producer:     peerInfo,
+	}
+	body, err := json.Marshal(ev)
+	if err != nil {
+		e.nsqlookupd.logf(LOG_ERROR, "EVENTS: failed to marshal event - %s", err)
+		return
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(ev.key()))
+	partition := h.Sum32() % eventPartitions
+	for _, s := range e.sinks {
+		select {
+		case s.partitions[partition] <- queuedEvent{key: ev.key(), body: body}:
+		default:
+			e.nsqlookupd.logf(LOG_ERROR, "EVENTS: %s queue full, dropping %s event %d",
+				s.name, typ, ev.Sequence)
+		}
+	}
+}
+
+// start delivers the events of every partition of every sink until exit
+func (e *eventEmitter) start() {
+	for _, s := range e.sinks {
+		for _, partition := range s.partitions {
+			s, partition := s, partition
+			e.nsqlookupd.waitGroup.Wrap(func() { e.deliverLoop(s, partition) })
+		}
+	}
+}
+
+func (e *eventEmitter) deliverLoop(s *eventSink, partition chan queuedEvent) {
+	opts := e.nsqlookupd.opts
+	for {
+		var qe queuedEvent
+		select {
+		case qe = <-partition:
+		case <-e.nsqlookupd.exitChan:
+			goto exit
+		}
+
+		backoff := opts.EventRetryBackoff
+		for attempt := 1; ; attempt++ {
+			err := s.deliver(qe.body)
+			if err == nil {
+				break
+			}
+			if attempt >= opts.EventMaxAttempts {
+				e.nsqlookupd.logf(LOG_ERROR, "EVENTS: giving up on %s event for %q after %d attempts - %s",
+					s.name, qe.key, attempt, err)
+				break
+			}
+			e.nsqlookupd.logf(LOG_WARN, "EVENTS: failed to deliver %s event for %q (attempt %d), retrying in %s - %s",
+				s.name, qe.key, attempt, backoff, err)
+			select {
+			case <-time.After(backoff):
+			case <-e.nsqlookupd.exitChan:
+				goto exit
+			}
+			backoff *= 2
+			if backoff > maxEventRetryBackoff {
+				backoff = maxEventRetryBackoff
+			}
+		}
+	}
+
+exit:
+	if n := len(partition); n > 0 {
+		e.nsqlookupd.logf(LOG_WARN, "EVENTS: %s closing with %d undelivered events", s.name, n)
+	}
+}
@@ -0,0 +1,628 @@
+package nsqlookupd
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nsqio/nsq/internal/http_api"
+)
+
+const (
+	gossipConnectTimeout = 2 * time.Second
+	gossipRequestTimeout = 5 * time.Second
+)
+
+// clusterState is what an nsqlookupd serves to its peers, its own
+// registrations, the tombstones it has been asked to set and the deletions
+// it has applied to its registrations
+type clusterState struct {
+	snapshot
+	Tombstones   []clusterTombstone   `json:"tombstones"`
+	Untombstones []clusterUntombstone `json:"untombstones,omitempty"`
+	Deletions    []clusterDeletion    `json:"deletions,omitempty"`
+}
+
+type clusterTombstone struct {
+	Topic        string `json:"topic"`
+	Node         string `json:"node"`
+	TombstonedAt int64  `json:"tombstoned_at"`
+}
+
+type clusterUntombstone struct {
+	Topic          string `json:"topic"`
+	Node           string `json:"node"`
+	UntombstonedAt int64  `json:"untombstoned_at"`
+}
+
+// clusterDeletion is a topic, or a channel when Channel is set, deleted
+// through /topic/delete or /channel/delete of any nsqlookupd
+type clusterDeletion struct {
+	Topic     string `json:"topic"`
+	Channel   string `json:"channel,omitempty"`
+	DeletedAt int64  `json:"deleted_at"`
+}
+
+type tombstoneKey struct {
+	topic string
+	node  string // <broadcast_address>:<http_port>, as given to /topic/tombstone
+}
+
+// tombstoneState is the latest tombstone of a node for a topic or, when
+// cleared, the latest un-tombstone. The most recent one wins across peers.
+type tombstoneState struct {
+	at      int64
+	cleared bool
+}
+
+type gossipPeer struct {
+	addr     string
+	state    *clusterState
+	lastSync time.Time
+}
+
+// gossip keeps a view of the registrations held by peer nsqlookupd, which is
+// merged with the local RegistrationDB to answer queries.
+//
+// Each node periodically pulls the local state of every peer (anti-entropy)
+// rather than relaying what it learned, so peers should form a full mesh.
+type gossip struct {
+	sync.RWMutex
+	nsqlookupd *NSQLookupd
+	client     *http_api.Client
+	peers      []*gossipPeer
+
+	// the merged registrations of all peers, nil until the first sync
+	remote *RegistrationDB
+	// tombstones set or cleared through this node
+	localTombstones map[tombstoneKey]tombstoneState
+	// tombstones set or cleared through this node or any peer
+	tombstones map[tombstoneKey]tombstoneState
+	// deletions made through this node or any peer, keyed by the topic or
+	// channel registration, which have been applied to the local DB
+	deletions map[Registration]int64
+}
+
+func newGossip(l *NSQLookupd) *gossip {
+	g := &gossip{
+		nsqlookupd:      l,
+		client:          http_api.NewClient(nil, gossipConnectTimeout, gossipRequestTimeout),
+		localTombstones: make(map[tombstoneKey]tombstoneState),
+		tombstones:      make(map[tombstoneKey]tombstoneState),
+		deletions:       make(map[Registration]int64),
+	}
+	if l.opts.PeerAuthSecret != "" {
+		g.client = g.client.WithAuthSecret(l.opts.PeerAuthSecret)
+	}
+	for _, addr := range l.opts.PeerHTTPAddresses {
+		g.peers = append(g.peers, &gossipPeer{addr: addr})
+	}
+	return g
+}
+
+func (p *PeerInfo) nodeKey() string {
+	return fmt.Sprintf("%s:%d:%d", p.BroadcastAddress, p.TCPPort, p.HTTPPort)
+}
+
+func (p *PeerInfo) tombstoneNode() string {
+	return fmt.Sprintf("%s:%d", p.BroadcastAddress, p.HTTPPort)
+}
+
+// loop syncs with every peer each GossipInterval until exit
+func (g *gossip) loop() {
+	ticker := time.NewTicker(g.nsqlookupd.opts.GossipInterval)
+	for {
+		g.sync()
+
+		select {
+		case <-ticker.C:
+		case <-g.nsqlookupd.exitChan:
+			goto exit
+		}
+	}
+
+exit:
+	g.nsqlookupd.logf(LOG_INFO, "GOSSIP: closing")
+	ticker.Stop()
+}
+
+func (g *gossip) sync() {
+	for _, peer := range g.peers {
+		var state clusterState
+		endpoint := fmt.Sprintf("http://%s/gossip", peer.addr)
+		err := g.client.GETV1(endpoint, &state)
+		if err != nil {
+			g.nsqlookupd.logf(LOG_WARN, "GOSSIP: failed to sync with peer(%s) - %s", peer.addr, err)
+			continue
+		}
+		g.nsqlookupd.logf(LOG_DEBUG, "GOSSIP: synced %d registrations from peer(%s)",
+			len(state.Registrations), peer.addr)
+		peer.state = &state
+		peer.lastSync = time.Now()
+	}
+	g.rebuild()
+}
+
+// rebuild merges the last state of each peer into a fresh remote view.
+//
+// The same nsqd can be known to several peers, it is identified by its
+// addresses and the most recent liveness and tombstone reported wins.
+func (g *gossip) rebuild() {
+	now := time.Now()
+	deletions := g.mergeDeletions(now)
+	remote := NewRegistrationDB()
+	nodes := make(map[string]*PeerInfo)
+	tombstones := make(map[tombstoneKey]tombstoneState)
+	mergeTombstone := func(k tombstoneKey, st tombstoneState) {
+		if st.at > tombstones[k].at {
+			tombstones[k] = st
+		}
+	}
+	for _, peer := range g.peers {
+		// forget peers that have been unreachable for as long as a producer
+		// may go without pinging
+		if peer.state == nil || now.Sub(peer.lastSync) > g.nsqlookupd.opts.InactiveProducerTimeout {
+			continue
+		}
+		applied := make(map[Registration]int64, len(peer.state.Deletions))
+		for _, d := range peer.state.Deletions {
+			applied[d.registration()] = d.DeletedAt
+		}
+		for _, r := range peer.state.Registrations {
+			// skip what was deleted since the peer's state was taken
+			if isDeleted(r.Registration, deletions, applied) {
+				continue
+			}
+			producers, ok := remote.registrationMap[r.Registration]
+			if !ok {
+				producers = make(ProducerMap)
+				remote.registrationMap[r.Registration] = producers
+			}
+			for _, pp := range r.Producers {
+				if pp == nil || pp.PeerInfo == nil {
+					continue
+				}
+				key := pp.PeerInfo.nodeKey()
+				peerInfo, ok := nodes[key]
+				if !ok {
+					peerInfo = pp.PeerInfo
+					peerInfo.id = key
+					peerInfo.stale = pp.Stale
+					peerInfo.lastUpdate = pp.LastUpdate
+					nodes[key] = peerInfo
+				} else {
+					peerInfo.stale = peerInfo.stale && pp.Stale
+					if pp.LastUpdate > peerInfo.lastUpdate {
+						peerInfo.lastUpdate = pp.LastUpdate
+					}
+				}
+				if pp.LoadHints != nil {
+					peerInfo.setLoadHints(pp.LoadHints)
+				}
+				p, ok := producers[key]
+				if !ok {
+					p = &Producer{peerInfo: peerInfo}
+					producers[key] = p
+				}
+				if pp.TombstonedAt > p.tombstonedAt.UnixNano() {
+					p.tombstoned = true
+					p.tombstonedAt = time.Unix(0, pp.TombstonedAt)
+				}
+			}
+		}
+		for _, t := range peer.state.Tombstones {
+			mergeTombstone(tombstoneKey{t.Topic, t.Node}, tombstoneState{at: t.TombstonedAt})
+		}
+		for _, t := range peer.state.Untombstones {
+			mergeTombstone(tombstoneKey{t.Topic, t.Node}, tombstoneState{at: t.UntombstonedAt, cleared: true})
+		}
+	}
+
+	g.Lock()
+	g.expireTombstones(now)
+	for k, st := range g.localTombstones {
+		mergeTombstone(k, st)
+	}
+	oldRemote, oldTombstones := g.remote, g.tombstones
+	g.remote = remote
+	g.tombstones = tombstones
+	g.Unlock()
+
+	g.notifyChanges(oldRemote, remote)
+	for k, st := range tombstones {
+		if st.at > oldTombstones[k].at {
+			g.notifyTombstone(k, st)
+		}
+	}
+}
+
+// registration is the topic or channel registration d applies to
+func (d clusterDeletion) registration() Registration {
+	if d.Channel != "" {
+		return Registration{"channel", d.Topic, d.Channel}
+	}
+	return Registration{"topic", d.Topic, ""}
+}
+
+// isDeleted returns whether r, taken from a peer which had applied the
+// deletions in applied, is covered by a more recent deletion. The channel
+// registrations of a deleted topic are deleted with it.
+func isDeleted(r Registration, deletions map[Registration]int64, applied map[Registration]int64) bool {
+	keys := []Registration{{"topic", r.Key, ""}}
+	if r.Category == "channel" {
+		keys = append(keys, Registration{"channel", r.Key, r.SubKey})
+	}
+	for _, k := range keys {
+		if deletions[k] > applied[k] {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeDeletions applies the deletions of peers which are more recent than
+// the ones already applied to the local DB and returns every deletion known
+func (g *gossip) mergeDeletions(now time.Time) map[Registration]int64 {
+	g.RLock()
+	deletions := make(map[Registration]int64, len(g.deletions))
+	for k, at := range g.deletions {
+		deletions[k] = at
+	}
+	g.RUnlock()
+
+	var learnt []Registration
+	for _, peer := range g.peers {
+		if peer.state == nil {
+			continue
+		}
+		for _, d := range peer.state.Deletions {
+			k := d.registration()
+			if d.DeletedAt > deletions[k] {
+				deletions[k] = d.DeletedAt
+				learnt = append(learnt, k)
+			}
+		}
+	}
+	// remove the registrations before recording the deletions as applied,
+	// peers trust local registrations served alongside them
+	for _, k := range learnt {
+		if k.Category == "channel" {
+			g.nsqlookupd.logf(LOG_INFO, "GOSSIP: removing deleted channel(%s) from topic(%s)", k.SubKey, k.Key)
+		} else {
+			g.nsqlookupd.logf(LOG_INFO, "GOSSIP: removing deleted topic(%s)", k.Key)
+		}
+		g.removeDeleted(k)
+	}
+
+	g.Lock()
+	for k, at := range deletions {
+		if at > g.deletions[k] {
+			g.deletions[k] = at
+		}
+	}
+	g.expireDeletions(now)
+	for k, at := range g.deletions {
+		deletions[k] = at
+	}
+	g.Unlock()
+	return deletions
+}
+
+// removeDeleted removes the registrations of a deleted topic, including its
+// channels, or channel from the local DB
+func (g *gossip) removeDeleted(k Registration) {
+	db := g.nsqlookupd.DB
+	if k.Category == "topic" {
+		for _, r := range db.FindRegistrations("channel", k.Key, "*") {
+			db.RemoveRegistration(r)
+		}
+	}
+	for _, r := range db.FindRegistrations(k.Category, k.Key, k.SubKey) {
+		db.RemoveRegistration(r)
+	}
+}
+
+// expireDeletions drops deletions older than the tombstone lifetime, by then
+// every peer has applied them, callers hold the write lock
+func (g *gossip) expireDeletions(now time.Time) {
+	for k, at := range g.deletions {
+		if now.Sub(time.Unix(0, at)) > g.nsqlookupd.opts.TombstoneLifetime {
+			delete(g.deletions, k)
+		}
+	}
+}
+
+// deleted records that a topic or channel was deleted through this node, its
+// registrations have already been removed from the local DB
+func (g *gossip) deleted(k Registration) {
+	now := time.Now()
+	g.Lock()
+	defer g.Unlock()
+	g.expireDeletions(now)
+	g.deletions[k] = now.UnixNano()
+	if g.remote == nil {
+		return
+	}
+	// readers hold on to the remote view, so replace rather than modify it
+	remote := NewRegistrationDB()
+	for r, producers := range g.remote.registrationMap {
+		if r.Key == k.Key && (k.Category == "topic" || r == k) {
+			continue
+		}
+		remote.registrationMap[r] = producers
+	}
+	g.remote = remote
+}
+
+// notifyChanges tells watchers about topic producers that appeared,
+// disappeared or were tombstoned between two remote views
+func (g *gossip) notifyChanges(old *RegistrationDB, cur *RegistrationDB) {
+	w := g.nsqlookupd.DB.watch
+	if w == nil {
+		return
+	}
+	for k, producers := range cur.registrationMap {
+		if k.Category != "topic" {
+			continue
+		}
+		var prev ProducerMap
+		if old != nil {
+			prev = old.registrationMap[k]
+		}
+		for id, p := range producers {
+			op, ok := prev[id]
+			if !ok {
+				w.append(watchAdd, k.Key, p.peerInfo)
+			} else if p.tombstonedAt.After(op.tombstonedAt) {
+				w.append(watchTombstone, k.Key, p.peerInfo)
+			} else if op.tombstoned && !p.tombstoned {
+				w.append(watchUntombstone, k.Key, p.peerInfo)
+			}
+		}
+	}
+	if old == nil {
+		return
+	}
+	for k, producers := range old.registrationMap {
+		if k.Category != "topic" {
+			continue
+		}
+		for id, p := range producers {
+			if _, ok := cur.registrationMap[k][id]; !ok {
+				w.append(watchRemove, k.Key, p.peerInfo)
+			}
+		}
+	}
+}
+
+// notifyTombstone tells watchers about the producers a tombstone (or
+// un-tombstone) applies to
+func (g *gossip) notifyTombstone(k tombstoneKey, st tombstoneState) {
+	w := g.nsqlookupd.DB.watch
+	if w == nil {
+		return
+	}
+	typ := watchTombstone
+	if st.cleared {
+		typ = watchUntombstone
+	}
+	for _, p := range g.findProducers("topic", k.topic, "") {
+		if p.peerInfo.tombstoneNode() == k.node {
+			w.append(typ, k.topic, p.peerInfo)
+		}
+	}
+}
+
+// expireTombstones drops local tombstones (and un-tombstones, which only
+// need to outlive the tombstones they clear) older than the tombstone
+// lifetime, callers hold the write lock
+func (g *gossip) expireTombstones(now time.Time) {
+	for k, st := range g.localTombstones {
+		if now.Sub(time.Unix(0, st.at)) > g.nsqlookupd.opts.TombstoneLifetime {
+			delete(g.localTombstones, k)
+		}
+	}
+}
+
+// tombstone records that node was tombstoned for topic through this node so
+// that peers apply it even when the nsqd is not connected to this node
+func (g *gossip) tombstone(topic string, node string) {
+	g.setTombstone(tombstoneKey{topic, node}, false)
+}
+
+// untombstone records that the tombstone of node for topic was cleared
+// through this node, overriding older tombstones set through any peer
+func (g *gossip) untombstone(topic string, node string) {
+	g.setTombstone(tombstoneKey{topic, node}, true)
+}
+
+func (g *gossip) setTombstone(k tombstoneKey, cleared bool) {
+	now := time.Now()
+	st := tombstoneState{at: now.UnixNano(), cleared: cleared}
+	g.Lock()
+	g.expireTombstones(now)
+	g.localTombstones[k] = st
+	// readers hold on to the map returned by view, so replace rather than modify it
+	tombstones := make(map[tombstoneKey]tombstoneState, len(g.tombstones)+1)
+	for k, st := range g.tombstones {
+		tombstones[k] = st
+	}
+	tombstones[k] = st
+	g.tombstones = tombstones
+	remote := g.remote
+	g.Unlock()
+
+	// local producers notify watchers as they are updated in the DB
+	typ := watchTombstone
+	if cleared {
+		typ = watchUntombstone
+	}
+	if w := g.nsqlookupd.DB.watch; w != nil && remote != nil {
+		for _, p := range remote.registrationMap[Registration{"topic", k.topic, ""}] {
+			if p.peerInfo.tombstoneNode() == k.node {
+				w.append(typ, k.topic, p.peerInfo)
+			}
+		}
+	}
+}
+
+// localState is served to peers
+func (g *gossip) localState() *clusterState {
+	// deletions are read before the registrations, which therefore reflect
+	// at least those deletions
+	var deletions []clusterDeletion
+	g.RLock()
+	for k, at := range g.deletions {
+		d := clusterDeletion{Topic: k.Key, DeletedAt: at}
+		if k.Category == "channel" {
+			d.Channel = k.SubKey
+		}
+		deletions = append(deletions, d)
+	}
+	g.RUnlock()
+
+	state := &clusterState{
+		snapshot:  *g.nsqlookupd.DB.snapshot(),
+		Deletions: deletions,
+	}
+	g.Lock()
+	g.expireTombstones(time.Now())
+	for k, st := range g.localTombstones {
+		if st.cleared {
+			state.Untombstones = append(state.Untombstones, clusterUntombstone{
+				Topic:          k.topic,
+				Node:           k.node,
+				UntombstonedAt: st.at,
+			})
+			continue
+		}
+		state.Tombstones = append(state.Tombstones, clusterTombstone{
+			Topic:        k.topic,
+			Node:         k.node,
+			TombstonedAt: st.at,
+		})
+	}
+	g.Unlock()
+	return state
+}
+
+func (g *gossip) view() (*RegistrationDB, map[tombstoneKey]tombstoneState) {
+	g.RLock()
+	defer g.RUnlock()
+	return g.remote, g.tombstones
+}
+
+// findRegistrations is RegistrationDB.FindRegistrations across the cluster
+func (g *gossip) findRegistrations(category string, key string, subkey string) Registrations {
+	results := g.nsqlookupd.DB.FindRegistrations(category, key, subkey)
+	remote, _ := g.view()
+	if remote == nil {
+		return results
+	}
+	seen := make(map[Registration]struct{}, len(results))
+	for _, k := range results {
+		seen[k] = struct{}{}
+	}
+	for _, k := range remote.FindRegistrations(category, key, subkey) {
+		if _, ok := seen[k]; !ok {
+			results = append(results, k)
+		}
+	}
+	return results
+}
+
+// findProducers is RegistrationDB.FindProducers across the cluster, with one
+// producer per nsqd and tombstones set anywhere in the cluster applied
+func (g *gossip) findProducers(category string, key string, subkey string) Producers {
+	local := g.nsqlookupd.DB.FindProducers(category, key, subkey)
+	remote, tombstones := g.view()
+	if remote == nil && len(tombstones) == 0 {
+		return local
+	}
+
+	var results Producers
+	index := make(map[string]int)
+	add := func(p *Producer) {
+		k := p.peerInfo.nodeKey()
+		i, ok := index[k]
+		if !ok {
+			index[k] = len(results)
+			results = append(results, p)
+			return
+		}
+		cur := results[i]
+		merged := &Producer{
+			peerInfo:     cur.peerInfo,
+			tombstoned:   cur.tombstoned,
+			tombstonedAt: cur.tombstonedAt,
+		}
+		if cur.peerInfo.stale && !p.peerInfo.stale {
+			merged.peerInfo = p.peerInfo
+		}
+		if p.tombstoned && p.tombstonedAt.After(merged.tombstonedAt) {
+			merged.tombstoned = true
+			merged.tombstonedAt = p.tombstonedAt
+		}
+		results[i] = merged
+	}
+	for _, p := range local {
+		add(p)
+	}
+	if remote != nil {
+		for _, p := range remote.FindProducers(category, key, subkey) {
+			add(p)
+		}
+	}
+
+	if category == "topic" && len(tombstones) > 0 {
+		for i, p := range results {
+			st, ok := tombstones[tombstoneKey{key, p.peerInfo.tombstoneNode()}]
+			if !ok {
+				continue
+			}
+			if st.cleared {
+				if p.tombstoned && p.tombstonedAt.UnixNano() <= st.at {
+					results[i] = &Producer{peerInfo: p.peerInfo}
+				}
+				continue
+			}
+			if p.tombstoned && p.tombstonedAt.UnixNano() >= st.at {
+				continue
+			}
+			results[i] = &Producer{
+				peerInfo:     p.peerInfo,
+				tombstoned:   true,
+				tombstonedAt: time.Unix(0, st.at),
+			}
+		}
+	}
+	return results
+}
+
+// lookupRegistrations is RegistrationDB.LookupRegistrations across the
+// cluster for the nsqd described by peerInfo
+func (g *gossip) lookupRegistrations(peerInfo *PeerInfo) Registrations {
+	results := Registrations{}
+	seen := make(map[Registration]struct{})
+	collect := func(db *RegistrationDB) {
+		db.RLock()
+		defer db.RUnlock()
+		for k, producers := range db.registrationMap {
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			for _, p := range producers {
+				if p.peerInfo.isSameNode(peerInfo) {
+					seen[k] = struct{}{}
+					results = append(results, k)
+					break
+				}
+			}
+		}
+	}
+	collect(g.nsqlookupd.DB)
+	if remote, _ := g.view(); remote != nil {
+		collect(remote)
+	}
+	return results
+}
@@ -1,10 +1,13 @@
 package nsqlookupd
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/pprof"
+	"strconv"
 	"sync/atomic"
+	"time"
 
 	"github.com/julienschmidt/httprouter"
 	"github.com/nsqio/nsq/internal/http_api"
@@ -36,9 +39,14 @@ func newHTTPServer(l *NSQLookupd) *httpServer {
 	// v1 negotiate
 	router.Handle("GET", "/debug", http_api.Decorate(s.doDebug, log, http_api.V1))
 	router.Handle("GET", "/lookup", http_api.Decorate(s.doLookup, log, http_api.V1))
+	router.Handle("GET", "/publishers", http_api.Decorate(s.doPublishers, log, http_api.V1))
 	router.Handle("GET", "/topics", http_api.Decorate(s.doTopics, log, http_api.V1))
 	router.Handle("GET", "/channels", http_api.Decorate(s.doChannels, log, http_api.V1))
 	router.Handle("GET", "/nodes", http_api.Decorate(s.doNodes, log, http_api.V1))
+	router.Handle("GET", "/gossip", http_api.Decorate(s.doGossip, log, http_api.V1))
+	router.Handle("GET", "/watch", http_api.Decorate(s.doWatch, log, http_api.V1))
+	router.Handle("GET", "/tombstones", http_api.Decorate(s.doTombstones, log, http_api.V1))
+	router.Handle("GET", "/metadata", http_api.Decorate(s.doMetadata, log, http_api.V1))
 
 	// only v1
 	router.Handle("POST", "/topic/create", http_api.Decorate(s.doCreateTopic, log, http_api.V1))
@@ -46,17 +54,20 @@ func newHTTPServer(l *NSQLookupd) *httpServer {
 	router.Handle("POST", "/channel/create", http_api.Decorate(s.doCreateChannel, log, http_api.V1))
 	router.Handle("POST", "/channel/delete", http_api.Decorate(s.doDeleteChannel, log, http_api.V1))
 	router.Handle("POST", "/topic/tombstone", http_api.Decorate(s.doTombstoneTopicProducer, log, http_api.V1))
+	router.Handle("POST", "/topic/untombstone", http_api.Decorate(s.doUntombstoneTopicProducer, log, http_api.V1))
+	router.Handle("POST", "/metadata", http_api.Decorate(s.doSetMetadata, log, http_api.V1))
+	router.Handle("DELETE", "/metadata", http_api.Decorate(s.doDeleteMetadata, log, http_api.V1))
 
 	// debug
-	router.HandlerFunc("GET", "/debug/pprof", pprof.Index)
-	router.HandlerFunc("GET", "/debug/pprof/cmdline", pprof.Cmdline)
-	router.HandlerFunc("GET", "/debug/pprof/symbol", pprof.Symbol)
-	router.HandlerFunc("POST", "/debug/pprof/symbol", pprof.Symbol)
-	router.HandlerFunc("GET", "/debug/pprof/profile", pprof.Profile)
-	router.Handler("GET", "/debug/pprof/heap", pprof.Handler("heap"))
-	router.Handler("GET", "/debug/pprof/goroutine", pprof.Handler("goroutine"))
-	router.Handler("GET", "/debug/pprof/block", pprof.Handler("block"))
-	router.Handler("GET", "/debug/pprof/threadcreate", pprof.Handler("threadcreate"))
+	router.Handler("GET", "/debug/pprof", s.adminOnly(http.HandlerFunc(pprof.Index)))
+	router.Handler("GET", "/debug/pprof/cmdline", s.adminOnly(http.HandlerFunc(pprof.Cmdline)))
+	router.Handler("GET", "/debug/pprof/symbol", s.adminOnly(http.HandlerFunc(pprof.Symbol)))
+	router.Handler("POST", "/debug/pprof/symbol", s.adminOnly(http.HandlerFunc(pprof.Symbol)))
+	router.Handler("GET", "/debug/pprof/profile", s.adminOnly(http.HandlerFunc(pprof.Profile)))
+	router.Handler("GET", "/debug/pprof/heap", s.adminOnly(pprof.Handler("heap")))
+	router.Handler("GET", "/debug/pprof/goroutine", s.adminOnly(pprof.Handler("goroutine")))
+	router.Handler("GET", "/debug/pprof/block", s.adminOnly(pprof.Handler("block")))
+	router.Handler("GET", "/debug/pprof/threadcreate", s.adminOnly(pprof.Handler("threadcreate")))
 
 	return s
 }
@@ -78,9 +89,15 @@ func (s *httpServer) doInfo(w http.ResponseWriter, req *http.Request, ps httprou
 }
 
 func (s *httpServer) doTopics(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
-	topics := s.nsqlookupd.DB.FindRegistrations("topic", "*", "").Keys()
+	err := s.checkAuth(req, permissionRead, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	topics := s.nsqlookupd.gossip.findRegistrations("topic", "*", "").Keys()
 	return map[string]interface{}{
-		"topics": topics,
+		"topics":   topics,
+		"metadata": s.nsqlookupd.metadata.forTopics(topics),
 	}, nil
 }
 
@@ -95,9 +112,15 @@ func (s *httpServer) doChannels(w http.ResponseWriter, req *http.Request, ps htt
 		return nil, http_api.Err{400, "MISSING_ARG_TOPIC"}
 	}
 
-	channels := s.nsqlookupd.DB.FindRegistrations("channel", topicName, "*").SubKeys()
+	err = s.checkAuth(req, permissionRead, topicName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	channels := s.nsqlookupd.gossip.findRegistrations("channel", topicName, "*").SubKeys()
 	return map[string]interface{}{
 		"channels": channels,
+		"metadata": s.nsqlookupd.metadata.forChannels(topicName, channels),
 	}, nil
 }
 
@@ -112,21 +135,159 @@ func (s *httpServer) doLookup(w http.ResponseWriter, req *http.Request, ps httpr
 		return nil, http_api.Err{400, "MISSING_ARG_TOPIC"}
 	}
 
-	registration := s.nsqlookupd.DB.FindRegistrations("topic", topicName, "")
+	err = s.checkAuth(req, permissionRead, topicName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	registration := s.nsqlookupd.gossip.findRegistrations("topic", topicName, "")
 	if len(registration) == 0 {
 		return nil, http_api.Err{404, "TOPIC_NOT_FOUND"}
 	}
 
-	channels := s.nsqlookupd.DB.FindRegistrations("channel", topicName, "*").SubKeys()
-	producers := s.nsqlookupd.DB.FindProducers("topic", topicName, "")
+	channels := s.nsqlookupd.gossip.findRegistrations("channel", topicName, "*").SubKeys()
+	producers := s.nsqlookupd.gossip.findProducers("topic", topicName, "")
 	producers = producers.FilterByActive(s.nsqlookupd.opts.InactiveProducerTimeout,
 		s.nsqlookupd.opts.TombstoneLifetime)
+	producers = producers.filterByTopology(newTopologyQuery(reqParams))
+	weighted := producers.weigh(topicName)
+	if healthyOnly, _ := reqParams.Get("healthy_only"); healthyOnly == "true" {
+		healthy := weighted[:0]
+		for _, w := range weighted {
+			if w.isHealthy() {
+				healthy = append(healthy, w)
+			}
+		}
+		weighted = healthy
+	}
 	return map[string]interface{}{
 		"channels":  channels,
-		"producers": producers.PeerInfo(),
+		"producers": weighted,
+	}, nil
+}
+
+const (
+	defaultWatchTimeout = 30 * time.Second
+	maxWatchTimeout     = 5 * time.Minute
+)
+
+// doPublishers returns the producers of a topic ranked best first for
+// publishing to
+func (s *httpServer) doPublishers(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	reqParams, err := http_api.NewReqParams(req)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_REQUEST"}
+	}
+
+	topicName, err := reqParams.Get("topic")
+	if err != nil {
+		return nil, http_api.Err{400, "MISSING_ARG_TOPIC"}
+	}
+
+	if !protocol.IsValidTopicName(topicName) {
+		return nil, http_api.Err{400, "INVALID_ARG_TOPIC"}
+	}
+
+	err = s.checkAuth(req, permissionRead, topicName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"producers": s.findPublishers(topicName, newTopologyQuery(reqParams)),
 	}, nil
 }
 
+// doWatch long-polls for changes to the producers of a topic.
+//
+// Without a revision the current producers are returned as "add" events,
+// along with the revision to resume from. With a revision the request waits
+// (up to timeout) for events after it.
+func (s *httpServer) doWatch(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	reqParams, err := http_api.NewReqParams(req)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_REQUEST"}
+	}
+
+	topicName, err := reqParams.Get("topic")
+	if err != nil {
+		return nil, http_api.Err{400, "MISSING_ARG_TOPIC"}
+	}
+
+	err = s.checkAuth(req, permissionRead, topicName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	watch := s.nsqlookupd.DB.watch
+
+	revisionStr, err := reqParams.Get("revision")
+	if err != nil {
+		// take the revision first so no change after the snapshot is missed
+		revision := watch.current()
+		producers := s.nsqlookupd.gossip.findProducers("topic", topicName, "")
+		producers = producers.FilterByActive(s.nsqlookupd.opts.InactiveProducerTimeout,
+			s.nsqlookupd.opts.TombstoneLifetime)
+		events := make([]watchEvent, 0, len(producers))
+		for _, peerInfo := range producers.PeerInfo() {
+			events = append(events, watchEvent{
+				Revision: revision,
+				Type:     watchAdd,
+				Topic:    topicName,
+				Producer: peerInfo,
+			})
+		}
+		return map[string]interface{}{
+			"revision": revision,
+			"events":   events,
+		}, nil
+	}
+
+	revision, err := strconv.ParseInt(revisionStr, 10, 64)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_ARG_REVISION"}
+	}
+
+	timeout := defaultWatchTimeout
+	if timeoutStr, err := reqParams.Get("timeout"); err == nil {
+		timeout, err = time.ParseDuration(timeoutStr)
+		if err != nil || timeout < 0 {
+			return nil, http_api.Err{400, "INVALID_ARG_TIMEOUT"}
+		}
+		if timeout > maxWatchTimeout {
+			timeout = maxWatchTimeout
+		}
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		events, current, wait, ok := watch.since(topicName, revision)
+		if !ok {
+			// the client must start over from the current producers
+			return nil, http_api.Err{410, "REVISION_EXPIRED"}
+		}
+		if len(events) > 0 {
+			return map[string]interface{}{
+				"revision": current,
+				"events":   events,
+			}, nil
+		}
+
+		select {
+		case <-wait:
+			continue
+		case <-timer.C:
+		case <-req.Context().Done():
+		case <-s.nsqlookupd.exitChan:
+		}
+		return map[string]interface{}{
+			"revision": current,
+			"events":   events,
+		}, nil
+	}
+}
+
 func (s *httpServer) doCreateTopic(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
 	reqParams, err := http_api.NewReqParams(req)
 	if err != nil {
@@ -142,6 +303,11 @@ func (s *httpServer) doCreateTopic(w http.ResponseWriter, req *http.Request, ps
 		return nil, http_api.Err{400, "INVALID_ARG_TOPIC"}
 	}
 
+	err = s.checkAuth(req, "admin", topicName, "")
+	if err != nil {
+		return nil, err
+	}
+
 	s.nsqlookupd.logf(LOG_INFO, "DB: adding topic(%s)", topicName)
 	key := Registration{"topic", topicName, ""}
 	s.nsqlookupd.DB.AddRegistration(key)
@@ -160,6 +326,11 @@ func (s *httpServer) doDeleteTopic(w http.ResponseWriter, req *http.Request, ps
 		return nil, http_api.Err{400, "MISSING_ARG_TOPIC"}
 	}
 
+	err = s.checkAuth(req, "admin", topicName, "")
+	if err != nil {
+		return nil, err
+	}
+
 	registrations := s.nsqlookupd.DB.FindRegistrations("channel", topicName, "*")
 	for _, registration := range registrations {
 		s.nsqlookupd.logf(LOG_INFO, "DB: removing channel(%s) from topic(%s)", registration.SubKey, topicName)
@@ -171,6 +342,13 @@ func (s *httpServer) doDeleteTopic(w http.ResponseWriter, req *http.Request, ps
 		s.nsqlookupd.logf(LOG_INFO, "DB: removing topic(%s)", topicName)
 		s.nsqlookupd.DB.RemoveRegistration(registration)
 	}
+	s.nsqlookupd.gossip.deleted(Registration{"topic", topicName, ""})
+
+	err = s.nsqlookupd.metadata.deleteTopic(topicName)
+	if err != nil {
+		s.nsqlookupd.logf(LOG_ERROR, "METADATA: %s", err)
+		return nil, http_api.Err{500, "INTERNAL_ERROR"}
+	}
 
 	return nil, nil
 }
@@ -186,23 +364,90 @@ func (s *httpServer) doTombstoneTopicProducer(w http.ResponseWriter, req *http.R
 		return nil, http_api.Err{400, "MISSING_ARG_TOPIC"}
 	}
 
+	err = s.checkAuth(req, "admin", topicName, "")
+	if err != nil {
+		return nil, err
+	}
+
 	node, err := reqParams.Get("node")
 	if err != nil {
 		return nil, http_api.Err{400, "MISSING_ARG_NODE"}
 	}
 
 	s.nsqlookupd.logf(LOG_INFO, "DB: setting tombstone for producer@%s of topic(%s)", node, topicName)
+	s.nsqlookupd.gossip.tombstone(topicName, node)
 	producers := s.nsqlookupd.DB.FindProducers("topic", topicName, "")
 	for _, p := range producers {
 		thisNode := fmt.Sprintf("%s:%d", p.peerInfo.BroadcastAddress, p.peerInfo.HTTPPort)
 		if thisNode == node {
-			p.Tombstone()
+			s.nsqlookupd.DB.TombstoneProducer(Registration{"topic", topicName, ""}, p)
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *httpServer) doUntombstoneTopicProducer(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	reqParams, err := http_api.NewReqParams(req)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_REQUEST"}
+	}
+
+	topicName, err := reqParams.Get("topic")
+	if err != nil {
+		return nil, http_api.Err{400, "MISSING_ARG_TOPIC"}
+	}
+
+	node, err := reqParams.Get("node")
+	if err != nil {
+		return nil, http_api.Err{400, "MISSING_ARG_NODE"}
+	}
+
+	err = s.checkAuth(req, "admin", topicName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	for _, t := range s.findTombstones(topicName) {
+		if t.Node == node {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, http_api.Err{404, "TOMBSTONE_NOT_FOUND"}
+	}
+
+	s.nsqlookupd.logf(LOG_INFO, "DB: clearing tombstone for producer@%s of topic(%s)", node, topicName)
+	s.nsqlookupd.gossip.untombstone(topicName, node)
+	producers := s.nsqlookupd.DB.FindProducers("topic", topicName, "")
+	for _, p := range producers {
+		if p.tombstoned && p.peerInfo.tombstoneNode() == node {
+			s.nsqlookupd.DB.UntombstoneProducer(Registration{"topic", topicName, ""}, p)
 		}
 	}
 
 	return nil, nil
 }
 
+func (s *httpServer) doTombstones(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	reqParams, err := http_api.NewReqParams(req)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_REQUEST"}
+	}
+
+	topicName, _ := reqParams.Get("topic")
+	err = s.checkAuth(req, permissionRead, topicName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"tombstones": s.findTombstones(topicName),
+	}, nil
+}
+
 func (s *httpServer) doCreateChannel(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
 	reqParams, err := http_api.NewReqParams(req)
 	if err != nil {
@@ -214,6 +459,11 @@ func (s *httpServer) doCreateChannel(w http.ResponseWriter, req *http.Request, p
 		return nil, http_api.Err{400, err.Error()}
 	}
 
+	err = s.checkAuth(req, "admin", topicName, channelName)
+	if err != nil {
+		return nil, err
+	}
+
 	s.nsqlookupd.logf(LOG_INFO, "DB: adding channel(%s) in topic(%s)", channelName, topicName)
 	key := Registration{"channel", topicName, channelName}
 	s.nsqlookupd.DB.AddRegistration(key)
@@ -236,15 +486,137 @@ func (s *httpServer) doDeleteChannel(w http.ResponseWriter, req *http.Request, p
 		return nil, http_api.Err{400, err.Error()}
 	}
 
-	registrations := s.nsqlookupd.DB.FindRegistrations("channel", topicName, channelName)
-	if len(registrations) == 0 {
+	err = s.checkAuth(req, "admin", topicName, channelName)
+	if err != nil {
+		return nil, err
+	}
+
+	// the channel may only be registered with peers
+	if len(s.nsqlookupd.gossip.findRegistrations("channel", topicName, channelName)) == 0 {
 		return nil, http_api.Err{404, "CHANNEL_NOT_FOUND"}
 	}
 
 	s.nsqlookupd.logf(LOG_INFO, "DB: removing channel(%s) from topic(%s)", channelName, topicName)
+	registrations := s.nsqlookupd.DB.FindRegistrations("channel", topicName, channelName)
 	for _, registration := range registrations {
 		s.nsqlookupd.DB.RemoveRegistration(registration)
 	}
+	s.nsqlookupd.gossip.deleted(Registration{"channel", topicName, channelName})
+
+	err = s.nsqlookupd.metadata.set(topicName, channelName, nil)
+	if err != nil {
+		s.nsqlookupd.logf(LOG_ERROR, "METADATA: %s", err)
+		return nil, http_api.Err{500, "INTERNAL_ERROR"}
+	}
+
+	return nil, nil
+}
+
+// getMetadataArgs returns the topic and optional channel of a /metadata request
+func getMetadataArgs(reqParams *http_api.ReqParams) (string, string, error) {
+	topicName, err := reqParams.Get("topic")
+	if err != nil {
+		return "", "", http_api.Err{400, "MISSING_ARG_TOPIC"}
+	}
+	if !protocol.IsValidTopicName(topicName) {
+		return "", "", http_api.Err{400, "INVALID_ARG_TOPIC"}
+	}
+
+	channelName, _ := reqParams.Get("channel")
+	if channelName != "" && !protocol.IsValidChannelName(channelName) {
+		return "", "", http_api.Err{400, "INVALID_ARG_CHANNEL"}
+	}
+
+	return topicName, channelName, nil
+}
+
+func (s *httpServer) doMetadata(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	reqParams, err := http_api.NewReqParams(req)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_REQUEST"}
+	}
+
+	topicName, channelName, err := getMetadataArgs(reqParams)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkAuth(req, permissionRead, topicName, channelName)
+	if err != nil {
+		return nil, err
+	}
+
+	e := s.nsqlookupd.metadata.get(topicName, channelName)
+	if e == nil {
+		e = &metadataEntry{Metadata: map[string]string{}}
+	}
+	return e, nil
+}
+
+func (s *httpServer) doSetMetadata(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	req.Body = http.MaxBytesReader(w, req.Body, maxMetadataBodySize)
+	reqParams, err := http_api.NewReqParams(req)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_REQUEST"}
+	}
+
+	topicName, channelName, err := getMetadataArgs(reqParams)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkAuth(req, "admin", topicName, channelName)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata map[string]string
+	err = json.Unmarshal(reqParams.Body, &metadata)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_BODY"}
+	}
+	err = validateMetadata(metadata)
+	if err != nil {
+		return nil, http_api.Err{400, fmt.Sprintf("INVALID_METADATA: %s", err)}
+	}
+
+	s.nsqlookupd.logf(LOG_INFO, "METADATA: setting %d keys on topic(%s) channel(%s)",
+		len(metadata), topicName, channelName)
+	err = s.nsqlookupd.metadata.set(topicName, channelName, metadata)
+	if err != nil {
+		s.nsqlookupd.logf(LOG_ERROR, "METADATA: %s", err)
+		return nil, http_api.Err{500, "INTERNAL_ERROR"}
+	}
+
+	return nil, nil
+}
+
+func (s *httpServer) doDeleteMetadata(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	reqParams, err := http_api.NewReqParams(req)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_REQUEST"}
+	}
+
+	topicName, channelName, err := getMetadataArgs(reqParams)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkAuth(req, "admin", topicName, channelName)
+	if err != nil {
+		return nil, err
+	}
+
+	if e := s.nsqlookupd.metadata.get(topicName, channelName); e == nil || len(e.Metadata) == 0 {
+		return nil, http_api.Err{404, "METADATA_NOT_FOUND"}
+	}
+
+	s.nsqlookupd.logf(LOG_INFO, "METADATA: deleting topic(%s) channel(%s)", topicName, channelName)
+	err = s.nsqlookupd.metadata.set(topicName, channelName, nil)
+	if err != nil {
+		s.nsqlookupd.logf(LOG_ERROR, "METADATA: %s", err)
+		return nil, http_api.Err{500, "INTERNAL_ERROR"}
+	}
 
 	return nil, nil
 }
@@ -256,30 +628,46 @@ type node struct {
 	TCPPort          int      `json:"tcp_port"`
 	HTTPPort         int      `json:"http_port"`
 	Version          string   `json:"version"`
+	Zone             string   `json:"zone,omitempty"`
+	Region           string   `json:"region,omitempty"`
+	Rack             string   `json:"rack,omitempty"`
+	Tags             []string `json:"tags,omitempty"`
+	Stale            bool     `json:"stale"`
 	Tombstones       []bool   `json:"tombstones"`
 	Topics           []string `json:"topics"`
 }
 
 func (s *httpServer) doNodes(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	reqParams, err := http_api.NewReqParams(req)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_REQUEST"}
+	}
+
+	err = s.checkAuth(req, permissionRead, "", "")
+	if err != nil {
+		return nil, err
+	}
+
 	// dont filter out tombstoned nodes
-	producers := s.nsqlookupd.DB.FindProducers("client", "", "").FilterByActive(
+	producers := s.nsqlookupd.gossip.findProducers("client", "", "").FilterByActive(
 		s.nsqlookupd.opts.InactiveProducerTimeout, 0)
+	producers = producers.filterByTopology(newTopologyQuery(reqParams))
 	nodes := make([]*node, len(producers))
 	topicProducersMap := make(map[string]Producers)
 	for i, p := range producers {
-		topics := s.nsqlookupd.DB.LookupRegistrations(p.peerInfo.id).Filter("topic", "*", "").Keys()
+		topics := s.nsqlookupd.gossip.lookupRegistrations(p.peerInfo).Filter("topic", "*", "").Keys()
 
 		// for each topic find the producer that matches this peer
 		// to add tombstone information
 		tombstones := make([]bool, len(topics))
 		for j, t := range topics {
 			if _, exists := topicProducersMap[t]; !exists {
-				topicProducersMap[t] = s.nsqlookupd.DB.FindProducers("topic", t, "")
+				topicProducersMap[t] = s.nsqlookupd.gossip.findProducers("topic", t, "")
 			}
 
 			topicProducers := topicProducersMap[t]
 			for _, tp := range topicProducers {
-				if tp.peerInfo == p.peerInfo {
+				if tp.peerInfo.isSameNode(p.peerInfo) {
 					tombstones[j] = tp.IsTombstoned(s.nsqlookupd.opts.TombstoneLifetime)
 					break
 				}
@@ -293,6 +681,11 @@ func (s *httpServer) doNodes(w http.ResponseWriter, req *http.Request, ps httpro
 			TCPPort:          p.peerInfo.TCPPort,
 			HTTPPort:         p.peerInfo.HTTPPort,
 			Version:          p.peerInfo.Version,
+			Zone:             p.peerInfo.Zone,
+			Region:           p.peerInfo.Region,
+			Rack:             p.peerInfo.Rack,
+			Tags:             p.peerInfo.Tags,
+			Stale:            p.IsStale(),
 			Tombstones:       tombstones,
 			Topics:           topics,
 		}
@@ -303,11 +696,42 @@ func (s *httpServer) doNodes(w http.ResponseWriter, req *http.Request, ps httpro
 	}, nil
 }
 
+// doGossip serves the local registrations, tombstones and deletions of this node to its peers
+func (s *httpServer) doGossip(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	err := s.checkAuth(req, permissionRead, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.nsqlookupd.gossip.localState(), nil
+}
+
 func (s *httpServer) doDebug(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	err := s.checkAuth(req, permissionRead, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	lifetime := s.nsqlookupd.opts.TombstoneLifetime
+	now := time.Now()
+
+	// tombstones set or cleared through this node or any peer
+	_, clusterTombstones := s.nsqlookupd.gossip.view()
+	tombstones := make([]map[string]interface{}, 0, len(clusterTombstones))
+	for k, st := range clusterTombstones {
+		tombstones = append(tombstones, map[string]interface{}{
+			"topic":   k.topic,
+			"node":    k.node,
+			"at":      st.at,
+			"cleared": st.cleared,
+		})
+	}
+
 	s.nsqlookupd.DB.RLock()
 	defer s.nsqlookupd.DB.RUnlock()
 
 	data := make(map[string][]map[string]interface{})
+	data["tombstones"] = tombstones
 	for r, producers := range s.nsqlookupd.DB.registrationMap {
 		key := r.Category + ":" + r.Key + ":" + r.SubKey
 		for _, p := range producers {
@@ -321,6 +745,10 @@ func (s *httpServer) doDebug(w http.ResponseWriter, req *http.Request, ps httpro
 				"last_update":       atomic.LoadInt64(&p.peerInfo.lastUpdate),
 				"tombstoned":        p.tombstoned,
 				"tombstoned_at":     p.tombstonedAt.UnixNano(),
+				"stale":             p.peerInfo.stale,
+			}
+			if p.IsTombstoned(lifetime) {
+				m["tombstone_remaining_lifetime"] = int64(lifetime - now.Sub(p.tombstonedAt))
 			}
 			data[key] = append(data[key], m)
 		}
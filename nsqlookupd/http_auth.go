@@ -0,0 +1,69 @@
+package nsqlookupd
+
+import (
+	"net/http"
+
+	"github.com/nsqio/nsq/internal/auth"
+	"github.com/nsqio/nsq/internal/http_api"
+)
+
+// permissionRead is granted by any permission on a topic, clients allowed to
+// publish or subscribe need to look up where to do so
+const permissionRead = "read"
+
+func isPermitted(state *auth.State, permission, topic, channel string) bool {
+	if permission != permissionRead {
+		return state.IsPermitted(permission, topic, channel)
+	}
+	for _, p := range []string{"subscribe", "publish", "admin"} {
+		if state.IsPermitted(p, topic, channel) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkAuth enforces permission on topic/channel for the credentials
+// presented with req, it is a no-op when auth is not enabled and, unless
+// --auth-read-required is set, for permissionRead.
+//
+// channel is empty for topic level actions, both are empty for node level actions
+func (s *httpServer) checkAuth(req *http.Request, permission, topic, channel string) error {
+	if !s.nsqlookupd.IsAuthEnabled() {
+		return nil
+	}
+	if permission == permissionRead && !s.nsqlookupd.opts.AuthReadRequired {
+		return nil
+	}
+
+	state, err := s.nsqlookupd.httpAuth.Authenticate(req)
+	if err == auth.ErrNoCredentials {
+		s.nsqlookupd.logf(LOG_WARN, "HTTP: [%s] denied %s %s without credentials",
+			req.RemoteAddr, req.Method, req.URL.Path)
+		return http_api.Err{403, "AUTH_REQUIRED"}
+	}
+	if err != nil {
+		// we don't want to leak errors contacting the auth server to untrusted clients
+		s.nsqlookupd.logf(LOG_WARN, "HTTP: [%s] AUTH failed %s", req.RemoteAddr, err)
+		return http_api.Err{403, "AUTH_FAILED"}
+	}
+
+	if !isPermitted(state, permission, topic, channel) {
+		s.nsqlookupd.logf(LOG_WARN, "HTTP: [%s] %q denied %s on %q %q (%s %s)",
+			req.RemoteAddr, state.Identity, permission, topic, channel, req.Method, req.URL.Path)
+		return http_api.Err{403, "UNAUTHORIZED"}
+	}
+	return nil
+}
+
+// adminOnly guards handlers that are not decorated by http_api
+func (s *httpServer) adminOnly(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		err := s.checkAuth(req, "admin", "", "")
+		if err != nil {
+			http_api.RespondV1(w, err.(http_api.Err).Code, err)
+			return
+		}
+		h.ServeHTTP(w, req)
+	})
+}
@@ -10,6 +10,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/nsqio/nsq/internal/clusterinfo"
+	"github.com/nsqio/nsq/internal/http_api"
+	"github.com/nsqio/nsq/internal/lg"
 	"github.com/nsqio/nsq/internal/test"
 	"github.com/nsqio/nsq/internal/version"
 	"github.com/nsqio/nsq/nsqd"
@@ -482,3 +485,245 @@ func TestDeleteChannel(t *testing.T) {
 	t.Logf("%s", body)
 	test.Equal(t, []byte(""), body)
 }
+
+func TestMetadata(t *testing.T) {
+	dataPath, err := ioutil.TempDir("", fmt.Sprintf("nsq-test-%d", time.Now().UnixNano()))
+	test.Nil(t, err)
+	defer os.RemoveAll(dataPath)
+
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.DataPath = dataPath
+	_, httpAddr, nsqlookupd1 := mustStartLookupd(opts)
+
+	topicName := "metadata_topic"
+	channelName := "metadata_channel"
+	makeChannel(nsqlookupd1, topicName, channelName)
+
+	client := http_api.NewClient(nil, ConnectTimeout, RequestTimeout)
+
+	type metadataDoc struct {
+		Metadata  map[string]string `json:"metadata"`
+		UpdatedAt int64             `json:"updated_at"`
+	}
+	var md metadataDoc
+	err = client.GETV1(fmt.Sprintf("http://%s/metadata?topic=%s", httpAddr, topicName), &md)
+	test.Nil(t, err)
+	test.Equal(t, 0, len(md.Metadata))
+
+	err = client.POSTV1JSON(fmt.Sprintf("http://%s/metadata?topic=%s", httpAddr, topicName),
+		map[string]string{"owner": "team-a", "description": "orders"})
+	test.Nil(t, err)
+	err = client.POSTV1JSON(fmt.Sprintf("http://%s/metadata?topic=%s&channel=%s", httpAddr, topicName, channelName),
+		map[string]string{"owner": "team-b", "slo_depth": "1000"})
+	test.Nil(t, err)
+	err = client.POSTV1JSON(fmt.Sprintf("http://%s/metadata?topic=%s", httpAddr, topicName),
+		map[string]string{"": "empty key"})
+	test.NotNil(t, err)
+
+	err = client.GETV1(fmt.Sprintf("http://%s/metadata?topic=%s", httpAddr, topicName), &md)
+	test.Nil(t, err)
+	test.Equal(t, map[string]string{"owner": "team-a", "description": "orders"}, md.Metadata)
+	test.Equal(t, true, md.UpdatedAt > 0)
+
+	var td struct {
+		Topics   []string                     `json:"topics"`
+		Metadata map[string]map[string]string `json:"metadata"`
+	}
+	err = client.GETV1(fmt.Sprintf("http://%s/topics", httpAddr), &td)
+	test.Nil(t, err)
+	test.Equal(t, "team-a", td.Metadata[topicName]["owner"])
+
+	var cd struct {
+		Channels []string                     `json:"channels"`
+		Metadata map[string]map[string]string `json:"metadata"`
+	}
+	err = client.GETV1(fmt.Sprintf("http://%s/channels?topic=%s", httpAddr, topicName), &cd)
+	test.Nil(t, err)
+	test.Equal(t, "1000", cd.Metadata[channelName]["slo_depth"])
+
+	// metadata is restored on restart
+	nsqlookupd1.Exit()
+	_, httpAddr, nsqlookupd2 := mustStartLookupd(opts)
+	defer nsqlookupd2.Exit()
+
+	md = metadataDoc{}
+	err = client.GETV1(fmt.Sprintf("http://%s/metadata?topic=%s&channel=%s", httpAddr, topicName, channelName), &md)
+	test.Nil(t, err)
+	test.Equal(t, "team-b", md.Metadata["owner"])
+
+	req, _ := http.NewRequest("DELETE", fmt.Sprintf("http://%s/metadata?topic=%s&channel=%s",
+		httpAddr, topicName, channelName), nil)
+	resp, err := http.DefaultClient.Do(req)
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 200, resp.StatusCode)
+
+	md = metadataDoc{}
+	err = client.GETV1(fmt.Sprintf("http://%s/metadata?topic=%s&channel=%s", httpAddr, topicName, channelName), &md)
+	test.Nil(t, err)
+	test.Equal(t, 0, len(md.Metadata))
+
+	// deleting a topic drops its metadata
+	err = client.POSTV1(fmt.Sprintf("http://%s/topic/delete?topic=%s", httpAddr, topicName))
+	test.Nil(t, err)
+	md = metadataDoc{}
+	err = client.GETV1(fmt.Sprintf("http://%s/metadata?topic=%s", httpAddr, topicName), &md)
+	test.Nil(t, err)
+	test.Equal(t, 0, len(md.Metadata))
+}
+
+func TestMetadataDelete(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	_, httpAddr1, nsqlookupd1 := mustStartLookupd(opts)
+	defer nsqlookupd1.Exit()
+	opts = NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	_, httpAddr2, nsqlookupd2 := mustStartLookupd(opts)
+	defer nsqlookupd2.Exit()
+
+	lookupdHTTPAddrs := []string{httpAddr1.String(), httpAddr2.String()}
+	topicName := "metadata_delete"
+
+	ci := clusterinfo.New(nil, http_api.NewClient(nil, ConnectTimeout, RequestTimeout))
+	err := ci.SetLookupdMetadata(topicName, "", map[string]string{"owner": "team-a"}, lookupdHTTPAddrs)
+	test.Nil(t, err)
+
+	// a delete missed by the second lookupd is not resurrected from it
+	err = ci.SetLookupdMetadata(topicName, "", nil, lookupdHTTPAddrs[:1])
+	test.Nil(t, err)
+	metadata, err := ci.GetLookupdMetadata(topicName, "", lookupdHTTPAddrs)
+	test.Nil(t, err)
+	test.Equal(t, 0, len(metadata))
+
+	var td struct {
+		Metadata map[string]map[string]string `json:"metadata"`
+	}
+	err = http_api.NewClient(nil, ConnectTimeout, RequestTimeout).GETV1(
+		fmt.Sprintf("http://%s/topics", httpAddr1), &td)
+	test.Nil(t, err)
+	test.Equal(t, 0, len(td.Metadata))
+
+	// a change that fails to persist is rejected and rolled back
+	nsqlookupd1.metadata.fileName = "/nonexistent/nsqlookupd.metadata.dat"
+	err = ci.SetLookupdMetadata(topicName, "", map[string]string{"owner": "team-b"}, lookupdHTTPAddrs[:1])
+	test.NotNil(t, err)
+	test.Equal(t, 0, len(nsqlookupd1.metadata.get(topicName, "").Metadata))
+}
+
+func TestMetadataExpireDeleted(t *testing.T) {
+	dataPath, err := ioutil.TempDir("", fmt.Sprintf("nsq-test-%d", time.Now().UnixNano()))
+	test.Nil(t, err)
+	defer os.RemoveAll(dataPath)
+
+	logf := func(lvl lg.LogLevel, f string, args ...interface{}) {}
+	m := newMetadataRegistry(dataPath, 10*time.Millisecond, logf)
+	test.Nil(t, m.set("deleted", "", map[string]string{"owner": "team-a"}))
+	test.Nil(t, m.deleteTopic("deleted"))
+	test.NotNil(t, m.get("deleted", ""))
+
+	// a deleted entry is dropped by the next change once it has expired
+	time.Sleep(20 * time.Millisecond)
+	test.Nil(t, m.set("kept", "", map[string]string{"owner": "team-b"}))
+	test.Nil(t, m.get("deleted", ""))
+
+	m = newMetadataRegistry(dataPath, 10*time.Millisecond, logf)
+	test.Nil(t, m.load())
+	test.Equal(t, 1, len(m.entries))
+	test.Equal(t, "team-b", m.get("kept", "").Metadata["owner"])
+}
+
+func TestNSQDLoadHints(t *testing.T) {
+	dataPath, nsqds, nsqlookupd1 := bootstrapNSQCluster(t)
+	defer os.RemoveAll(dataPath)
+	defer nsqds[0].Exit()
+	defer nsqlookupd1.Exit()
+
+	producers := nsqlookupd1.DB.FindProducers("client", "", "")
+	test.Equal(t, 1, len(producers))
+	hints := producers[0].peerInfo.getLoadHints()
+	test.NotNil(t, hints)
+	test.Equal(t, "OK", hints.Health)
+	test.Equal(t, true, hints.DiskUsage > 0 && hints.DiskUsage < 1)
+}
+
+func TestHTTPAuth(t *testing.T) {
+	policyFile, err := ioutil.TempFile("", "nsqlookupd-auth-policy-")
+	test.Nil(t, err)
+	defer os.Remove(policyFile.Name())
+	_, err = policyFile.WriteString(`{"identities":[
+		{"secret":"subsecret", "identity":"consumer",
+		 "authorizations":[{"topic":"test", "channels":[".*"], "permissions":["subscribe"]}]},
+		{"secret":"adminsecret", "identity":"operator",
+		 "authorizations":[{"topic":".*", "channels":[".*"], "permissions":["admin"]}]}
+	]}`)
+	test.Nil(t, err)
+	policyFile.Close()
+
+	for _, readRequired := range []bool{false, true} {
+		opts := NewOptions()
+		opts.Logger = test.NewTestLogger(t)
+		opts.AuthPolicyFile = policyFile.Name()
+		opts.AuthReadRequired = readRequired
+		_, httpAddr, nsqlookupd := mustStartLookupd(opts)
+		defer nsqlookupd.Exit()
+
+		do := func(method, endpoint, secret string) (int, string) {
+			req, err := http.NewRequest(method, fmt.Sprintf("http://%s%s", httpAddr, endpoint), nil)
+			test.Nil(t, err)
+			if secret != "" {
+				req.Header.Set("Authorization", "Bearer "+secret)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			test.Nil(t, err)
+			defer resp.Body.Close()
+			body, _ := ioutil.ReadAll(resp.Body)
+			return resp.StatusCode, string(body)
+		}
+
+		code, body := do("POST", "/topic/create?topic=test", "")
+		test.Equal(t, 403, code)
+		test.Equal(t, `{"message":"AUTH_REQUIRED"}`, body)
+
+		code, body = do("POST", "/topic/create?topic=test", "badsecret")
+		test.Equal(t, 403, code)
+		test.Equal(t, `{"message":"AUTH_FAILED"}`, body)
+
+		code, body = do("POST", "/topic/create?topic=test", "subsecret")
+		test.Equal(t, 403, code)
+		test.Equal(t, `{"message":"UNAUTHORIZED"}`, body)
+		test.Equal(t, 0, len(nsqlookupd.DB.FindRegistrations("topic", "test", "")))
+
+		code, _ = do("POST", "/topic/create?topic=test", "adminsecret")
+		test.Equal(t, 200, code)
+		test.Equal(t, 1, len(nsqlookupd.DB.FindRegistrations("topic", "test", "")))
+
+		code, _ = do("GET", "/debug/pprof/cmdline", "subsecret")
+		test.Equal(t, 403, code)
+
+		code, _ = do("GET", "/lookup?topic=test", "")
+		if readRequired {
+			test.Equal(t, 403, code)
+		} else {
+			test.Equal(t, 200, code)
+		}
+		code, _ = do("GET", "/lookup?topic=test", "subsecret")
+		test.Equal(t, 200, code)
+		code, _ = do("GET", "/topics", "subsecret")
+		if readRequired {
+			test.Equal(t, 403, code)
+		} else {
+			test.Equal(t, 200, code)
+		}
+		code, _ = do("GET", "/topics", "adminsecret")
+		test.Equal(t, 200, code)
+
+		// credentials passed by the shared HTTP client
+		client := http_api.NewClient(nil, ConnectTimeout, RequestTimeout)
+		endpoint := fmt.Sprintf("http://%s/channel/create?topic=test&channel=ch", httpAddr)
+		test.NotNil(t, client.POSTV1(endpoint))
+		test.Nil(t, client.WithAuthSecret("adminsecret").POSTV1(endpoint))
+
+	}
+}
@@ -0,0 +1,111 @@
+package nsqlookupd
+
+import (
+	"math"
+)
+
+// maxLoadBodySize bounds the body of a LOAD command
+const maxLoadBodySize = 5 * 1024 * 1024
+
+// LoadHints are periodically reported by nsqd with the LOAD command
+type LoadHints struct {
+	Health      string           `json:"health"`
+	Depth       int64            `json:"depth"`
+	TopicDepths map[string]int64 `json:"topic_depths"`
+	Clients     int64            `json:"clients"`
+	DiskUsage   float64          `json:"disk_usage"`
+}
+
+func (p *PeerInfo) getLoadHints() *LoadHints {
+	hints, _ := p.loadHints.Load().(*LoadHints)
+	return hints
+}
+
+func (p *PeerInfo) setLoadHints(hints *LoadHints) {
+	p.loadHints.Store(hints)
+}
+
+// producerLoad is the load of a producer for a given topic, as returned by /lookup
+type producerLoad struct {
+	Health     string  `json:"health"`
+	Depth      int64   `json:"depth"`
+	TopicDepth int64   `json:"topic_depth"`
+	Clients    int64   `json:"clients"`
+	DiskUsage  float64 `json:"disk_usage"`
+}
+
+type weightedProducer struct {
+	*PeerInfo
+	Load   *producerLoad `json:"load,omitempty"`
+	Weight int           `json:"weight"`
+}
+
+func (w *weightedProducer) isHealthy() bool {
+	return w.Load == nil || w.Load.Health == "OK"
+}
+
+// weigh computes a weight from 0 to 100 for each producer of a topic, higher
+// is better.
+//
+// Unhealthy producers weigh 0. The others are scaled down by their depth for
+// the topic and their client count relative to the busiest producer (up to
+// half each) and by the fraction of disk in use, then scaled so that the
+// least loaded producer weighs 100. Producers that have not reported load
+// hints are treated as idle.
+func (pp Producers) weigh(topic string) []*weightedProducer {
+	results := make([]*weightedProducer, 0, len(pp))
+	var maxDepth, maxClients int64
+	for _, p := range pp {
+		w := &weightedProducer{PeerInfo: p.peerInfo}
+		if hints := p.peerInfo.getLoadHints(); hints != nil {
+			w.Load = &producerLoad{
+				Health:     hints.Health,
+				Depth:      hints.Depth,
+				TopicDepth: hints.TopicDepths[topic],
+				Clients:    hints.Clients,
+				DiskUsage:  hints.DiskUsage,
+			}
+			if w.Load.TopicDepth > maxDepth {
+				maxDepth = w.Load.TopicDepth
+			}
+			if w.Load.Clients > maxClients {
+				maxClients = w.Load.Clients
+			}
+		}
+		results = append(results, w)
+	}
+
+	weights := make([]float64, len(results))
+	var maxWeight float64
+	for i, w := range results {
+		if !w.isHealthy() {
+			continue
+		}
+		weight := 1.0
+		if w.Load != nil {
+			if maxDepth > 0 {
+				weight *= 1 - float64(w.Load.TopicDepth)/float64(2*maxDepth)
+			}
+			if maxClients > 0 {
+				weight *= 1 - float64(w.Load.Clients)/float64(2*maxClients)
+			}
+			weight *= 1 - math.Min(math.Max(w.Load.DiskUsage, 0), 1)
+		}
+		weights[i] = weight
+		maxWeight = math.Max(maxWeight, weight)
+	}
+
+	for i, w := range results {
+		if !w.isHealthy() {
+			continue
+		}
+		// weights are relative to the best producer, and a healthy producer
+		// is never weighed out entirely
+		weight := 1.0
+		if maxWeight > 0 {
+			weight = weights[i] / maxWeight
+		}
+		w.Weight = int(math.Max(math.Round(100*weight), 1))
+	}
+	return results
+}
@@ -96,6 +96,8 @@ func (p *LookupProtocolV1) Exec(client *ClientV1, reader *bufio.Reader, params [
 		return p.REGISTER(client, reader, params[1:])
 	case "UNREGISTER":
 		return p.UNREGISTER(client, reader, params[1:])
+	case "LOAD":
+		return p.LOAD(client, reader, params[1:])
 	}
 	return nil, protocol.NewFatalClientErr(nil, "E_INVALID", fmt.Sprintf("invalid command %s", params[0]))
 }
@@ -236,6 +238,9 @@ func (p *LookupProtocolV1) IDENTIFY(client *ClientV1, reader *bufio.Reader, para
 		client, peerInfo.BroadcastAddress, peerInfo.TCPPort, peerInfo.HTTPPort, peerInfo.Version)
 
 	client.peerInfo = &peerInfo
+	if n := p.nsqlookupd.DB.ReplaceStaleProducers(client.peerInfo); n > 0 {
+		p.nsqlookupd.logf(LOG_INFO, "DB: client(%s) replaced %d restored producers", client, n)
+	}
 	if p.nsqlookupd.DB.AddProducer(Registration{"client", "", ""}, &Producer{peerInfo: client.peerInfo}) {
 		p.nsqlookupd.logf(LOG_INFO, "DB: client(%s) REGISTER category:%s key:%s subkey:%s", client, "client", "", "")
 	}
@@ -251,6 +256,8 @@ func (p *LookupProtocolV1) IDENTIFY(client *ClientV1, reader *bufio.Reader, para
 	}
 	data["broadcast_address"] = p.nsqlookupd.opts.BroadcastAddress
 	data["hostname"] = hostname
+	// this nsqlookupd accepts LOAD
+	data["load_hints"] = true
 
 	response, err := json.Marshal(data)
 	if err != nil {
@@ -260,6 +267,46 @@ func (p *LookupProtocolV1) IDENTIFY(client *ClientV1, reader *bufio.Reader, para
 	return response, nil
 }
 
+// LOAD records the load hints of an nsqd
+func (p *LookupProtocolV1) LOAD(client *ClientV1, reader *bufio.Reader, params []string) ([]byte, error) {
+	var err error
+
+	if client.peerInfo == nil {
+		return nil, protocol.NewFatalClientErr(nil, "E_INVALID", "client must IDENTIFY")
+	}
+
+	var bodyLen int32
+	err = binary.Read(reader, binary.BigEndian, &bodyLen)
+	if err != nil {
+		return nil, protocol.NewFatalClientErr(err, "E_BAD_BODY", "LOAD failed to read body size")
+	}
+
+	if bodyLen <= 0 || bodyLen > maxLoadBodySize {
+		return nil, protocol.NewFatalClientErr(nil, "E_BAD_BODY",
+			fmt.Sprintf("LOAD invalid body size %d", bodyLen))
+	}
+
+	body := make([]byte, bodyLen)
+	_, err = io.ReadFull(reader, body)
+	if err != nil {
+		return nil, protocol.NewFatalClientErr(err, "E_BAD_BODY", "LOAD failed to read body")
+	}
+
+	var hints LoadHints
+	err = json.Unmarshal(body, &hints)
+	if err != nil {
+		return nil, protocol.NewFatalClientErr(err, "E_BAD_BODY", "LOAD failed to decode JSON body")
+	}
+
+	p.nsqlookupd.logf(LOG_DEBUG, "CLIENT(%s): LOAD health:%s depth:%d clients:%d disk:%.2f",
+		client, hints.Health, hints.Depth, hints.Clients, hints.DiskUsage)
+
+	client.peerInfo.setLoadHints(&hints)
+	atomic.StoreInt64(&client.peerInfo.lastUpdate, time.Now().UnixNano())
+
+	return []byte("OK"), nil
+}
+
 func (p *LookupProtocolV1) PING(client *ClientV1, params []string) ([]byte, error) {
 	if client.peerInfo != nil {
 		// we could get a PING before other commands on the same client connection
@@ -0,0 +1,230 @@
+package nsqlookupd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/nsqio/nsq/internal/lg"
+)
+
+const (
+	maxMetadataBodySize    = 64 * 1024
+	maxMetadataKeys        = 64
+	maxMetadataKeyLength   = 128
+	maxMetadataValueLength = 4096
+)
+
+// metadataKey identifies a topic or, with a channel, one of its channels
+type metadataKey struct {
+	topic   string
+	channel string
+}
+
+// metadataEntry is the free form key/value metadata of a topic or channel,
+// such as its owner, a description or alerting thresholds.
+//
+// A deleted entry is kept with empty metadata for --metadata-tombstone-lifetime
+// so that its UpdatedAt wins over the stale copy of a lookupd that missed the
+// delete.
+type metadataEntry struct {
+	Metadata  map[string]string `json:"metadata"`
+	UpdatedAt int64             `json:"updated_at"`
+}
+
+type persistedMetadata struct {
+	Topic   string `json:"topic"`
+	Channel string `json:"channel,omitempty"`
+	metadataEntry
+}
+
+// metadataRegistry holds the metadata of topics and channels, persisted to
+// a file in --data-path when set.
+//
+// Metadata is independent of registrations, it can be set before a topic
+// exists and is only dropped when the topic or channel is deleted.
+//
+// Changes that fail to persist are rolled back.
+type metadataRegistry struct {
+	sync.RWMutex
+	entries           map[metadataKey]*metadataEntry
+	fileName          string
+	tombstoneLifetime time.Duration
+	logf              lg.AppLogFunc
+}
+
+func newMetadataRegistry(dataPath string, tombstoneLifetime time.Duration, logf lg.AppLogFunc) *metadataRegistry {
+	m := &metadataRegistry{
+		entries:           make(map[metadataKey]*metadataEntry),
+		tombstoneLifetime: tombstoneLifetime,
+		logf:              logf,
+	}
+	if dataPath != "" {
+		m.fileName = path.Join(dataPath, "nsqlookupd.metadata.dat")
+	}
+	return m
+}
+
+func validateMetadata(metadata map[string]string) error {
+	if len(metadata) > maxMetadataKeys {
+		return fmt.Errorf("too many keys (%d > %d)", len(metadata), maxMetadataKeys)
+	}
+	for k, v := range metadata {
+		if k == "" || len(k) > maxMetadataKeyLength {
+			return fmt.Errorf("invalid key %q", k)
+		}
+		if len(v) > maxMetadataValueLength {
+			return fmt.Errorf("value of %q too long (%d > %d)", k, len(v), maxMetadataValueLength)
+		}
+	}
+	return nil
+}
+
+func (m *metadataRegistry) load() error {
+	if m.fileName == "" {
+		return nil
+	}
+	data, err := ioutil.ReadFile(m.fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read metadata from %s - %s", m.fileName, err)
+	}
+
+	var entries []persistedMetadata
+	err = json.Unmarshal(data, &entries)
+	if err != nil {
+		return fmt.Errorf("failed to parse metadata in %s - %s", m.fileName, err)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	for _, e := range entries {
+		entry := e.metadataEntry
+		m.entries[metadataKey{e.Topic, e.Channel}] = &entry
+	}
+	return nil
+}
+
+// persist drops expired deleted entries and writes every other entry to disk,
+// callers hold the write lock
+func (m *metadataRegistry) persist() error {
+	expired := time.Now().Add(-m.tombstoneLifetime).UnixNano()
+	for k, e := range m.entries {
+		if len(e.Metadata) == 0 && e.UpdatedAt < expired {
+			delete(m.entries, k)
+		}
+	}
+
+	if m.fileName == "" {
+		return nil
+	}
+	entries := make([]persistedMetadata, 0, len(m.entries))
+	for k, e := range m.entries {
+		entries = append(entries, persistedMetadata{Topic: k.topic, Channel: k.channel, metadataEntry: *e})
+	}
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("failed to marshal metadata - %s", err)
+	}
+	tmpFileName := fmt.Sprintf("%s.%d.tmp", m.fileName, rand.Int())
+	err = writeSyncFile(tmpFileName, data)
+	if err == nil {
+		err = os.Rename(tmpFileName, m.fileName)
+	}
+	if err != nil {
+		os.Remove(tmpFileName)
+		return fmt.Errorf("failed to persist metadata to %s - %s", m.fileName, err)
+	}
+	return nil
+}
+
+// get returns the metadata of a topic or channel, nil when it has none.
+//
+// A deleted entry is returned with empty metadata.
+func (m *metadataRegistry) get(topic string, channel string) *metadataEntry {
+	m.RLock()
+	defer m.RUnlock()
+	return m.entries[metadataKey{topic, channel}]
+}
+
+// set replaces the metadata of a topic or channel, an empty map deletes it
+func (m *metadataRegistry) set(topic string, channel string, metadata map[string]string) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.update([]metadataKey{{topic, channel}}, metadata)
+}
+
+// deleteTopic drops the metadata of a topic and all its channels
+func (m *metadataRegistry) deleteTopic(topic string) error {
+	m.Lock()
+	defer m.Unlock()
+	keys := []metadataKey{{topic, ""}}
+	for k := range m.entries {
+		if k.topic == topic && k.channel != "" {
+			keys = append(keys, k)
+		}
+	}
+	return m.update(keys, nil)
+}
+
+// update sets the metadata of keys, restoring the previous entries when that
+// fails to persist. Callers hold the write lock.
+func (m *metadataRegistry) update(keys []metadataKey, metadata map[string]string) error {
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	// entries are never modified in place so readers can hold on to them
+	e := &metadataEntry{Metadata: metadata, UpdatedAt: time.Now().UnixNano()}
+
+	prev := make(map[metadataKey]*metadataEntry)
+	for _, k := range keys {
+		prev[k] = m.entries[k]
+		m.entries[k] = e
+	}
+
+	err := m.persist()
+	if err != nil {
+		for k, old := range prev {
+			if old == nil {
+				delete(m.entries, k)
+			} else {
+				m.entries[k] = old
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// forTopics returns the metadata of each topic which has some
+func (m *metadataRegistry) forTopics(topics []string) map[string]map[string]string {
+	m.RLock()
+	defer m.RUnlock()
+	results := make(map[string]map[string]string)
+	for _, topic := range topics {
+		if e, ok := m.entries[metadataKey{topic, ""}]; ok && len(e.Metadata) > 0 {
+			results[topic] = e.Metadata
+		}
+	}
+	return results
+}
+
+// forChannels returns the metadata of each channel of topic which has some
+func (m *metadataRegistry) forChannels(topic string, channels []string) map[string]map[string]string {
+	m.RLock()
+	defer m.RUnlock()
+	results := make(map[string]map[string]string)
+	for _, channel := range channels {
+		if e, ok := m.entries[metadataKey{topic, channel}]; ok && len(e.Metadata) > 0 {
+			results[channel] = e.Metadata
+		}
+	}
+	return results
+}
@@ -2,12 +2,14 @@ package nsqlookupd
 
 import (
 	"fmt"
-	"log"
 	"net"
 	"os"
 	"sync"
+	"time"
 
+	"github.com/nsqio/nsq/internal/auth"
 	"github.com/nsqio/nsq/internal/http_api"
+	"github.com/nsqio/nsq/internal/lg"
 	"github.com/nsqio/nsq/internal/protocol"
 	"github.com/nsqio/nsq/internal/util"
 	"github.com/nsqio/nsq/internal/version"
@@ -21,21 +23,70 @@ type NSQLookupd struct {
 	tcpServer    *tcpServer
 	waitGroup    util.WaitGroupWrapper
 	DB           *RegistrationDB
+	store        *registrationStore
+	gossip       *gossip
+	events       *eventEmitter
+	metadata     *metadataRegistry
+	authPolicy   *auth.PolicyWatcher
+	httpAuth     *auth.HTTPAuth
+	exitChan     chan int
+	persistDone  chan int
 }
 
 func New(opts *Options) (*NSQLookupd, error) {
 	var err error
 
 	if opts.Logger == nil {
-		opts.Logger = log.New(os.Stderr, opts.LogPrefix, log.Ldate|log.Ltime|log.Lmicroseconds)
+		logger, err := lg.NewLogger(opts.LogFormat, os.Stderr, opts.LogPrefix, "nsqlookupd")
+		if err != nil {
+			return nil, err
+		}
+		opts.Logger = logger
 	}
 	l := &NSQLookupd{
-		opts: opts,
-		DB:   NewRegistrationDB(),
+		opts:     opts,
+		DB:       NewRegistrationDB(),
+		exitChan: make(chan int),
 	}
 
+	l.DB.watch = newWatchLog()
+	l.gossip = newGossip(l)
+	l.httpAuth = auth.NewHTTPAuth(l.queryAuth)
+
 	l.logf(LOG_INFO, version.String("nsqlookupd"))
 
+	if opts.AuthPolicyFile != "" {
+		// credentials resolved by the previous policy may have been revoked
+		l.authPolicy, err = auth.NewPolicyWatcher(opts.AuthPolicyFile, l.logf, l.httpAuth.Clear)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load auth policy - %s", err)
+		}
+	}
+
+	l.metadata = newMetadataRegistry(opts.DataPath, opts.MetadataTombstoneLifetime, l.logf)
+	err = l.metadata.load()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.DataPath != "" {
+		l.store = newRegistrationStore(opts.DataPath, l.logf)
+		err = l.store.load(l.DB)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load registrations - %s", err)
+		}
+		// compact whatever was replayed into a fresh snapshot
+		err = l.store.snapshot(l.DB)
+		if err != nil {
+			return nil, fmt.Errorf("failed to persist registrations - %s", err)
+		}
+		err = l.store.open()
+		if err != nil {
+			return nil, err
+		}
+		l.DB.setStore(l.store)
+	}
+
 	l.tcpServer = &tcpServer{nsqlookupd: l}
 	l.tcpListener, err = net.Listen("tcp", opts.TCPAddress)
 	if err != nil {
@@ -46,6 +97,19 @@ func New(opts *Options) (*NSQLookupd, error) {
 		return nil, fmt.Errorf("listen (%s) failed - %s", opts.HTTPAddress, err)
 	}
 
+	if len(opts.EventWebhookURLs) > 0 || opts.EventNSQDHTTPAddress != "" {
+		if opts.EventNSQDHTTPAddress != "" && !protocol.IsValidTopicName(opts.EventTopic) {
+			return nil, fmt.Errorf("invalid --event-topic %q", opts.EventTopic)
+		}
+		l.events, err = newEventEmitter(l)
+		if err != nil {
+			return nil, err
+		}
+		l.DB.Lock()
+		l.DB.events = l.events
+		l.DB.Unlock()
+	}
+
 	return l, nil
 }
 
@@ -70,6 +134,18 @@ func (l *NSQLookupd) Main() error {
 	l.waitGroup.Wrap(func() {
 		exitFunc(http_api.Serve(l.httpListener, httpServer, "HTTP", l.logf))
 	})
+	if l.authPolicy != nil {
+		l.waitGroup.Wrap(func() { l.authPolicy.Run(l.opts.AuthPolicyRefresh, l.exitChan) })
+	}
+	if l.store != nil {
+		l.startPersistLoop()
+	}
+	if len(l.gossip.peers) > 0 {
+		l.waitGroup.Wrap(l.gossip.loop)
+	}
+	if l.events != nil {
+		l.events.start()
+	}
 
 	err := <-exitCh
 	return err
@@ -83,11 +159,80 @@ func (l *NSQLookupd) RealHTTPAddr() *net.TCPAddr {
 	return l.httpListener.Addr().(*net.TCPAddr)
 }
 
+func (l *NSQLookupd) IsAuthEnabled() bool {
+	return len(l.opts.AuthHTTPAddresses) != 0 || l.opts.AuthPolicyFile != ""
+}
+
+// startPersistLoop starts persistLoop unless nsqlookupd is already exiting,
+// Exit only waits for a loop that was started
+func (l *NSQLookupd) startPersistLoop() {
+	l.Lock()
+	defer l.Unlock()
+	select {
+	case <-l.exitChan:
+		return
+	default:
+	}
+	l.persistDone = make(chan int)
+	l.waitGroup.Wrap(l.persistLoop)
+}
+
+// persistLoop periodically snapshots the registration DB and expires
+// restored producers whose nsqd never came back
+func (l *NSQLookupd) persistLoop() {
+	ticker := time.NewTicker(l.opts.SnapshotInterval)
+	for {
+		select {
+		case <-ticker.C:
+		case <-l.exitChan:
+			goto exit
+		}
+
+		removed := l.DB.RemoveStaleProducers(time.Now().Add(-l.opts.InactiveProducerTimeout))
+		if removed > 0 {
+			l.logf(LOG_INFO, "PERSIST: removed %d stale producers", removed)
+		}
+		if !l.store.dirty() {
+			continue
+		}
+		err := l.store.snapshot(l.DB)
+		if err != nil {
+			l.logf(LOG_ERROR, "PERSIST: failed to snapshot registrations - %s", err)
+		}
+	}
+
+exit:
+	l.logf(LOG_INFO, "PERSIST: closing")
+	ticker.Stop()
+	close(l.persistDone)
+}
+
 func (l *NSQLookupd) Exit() {
 	if l.tcpListener != nil {
 		l.tcpListener.Close()
 	}
 
+	l.Lock()
+	close(l.exitChan)
+	persistDone := l.persistDone
+	l.Unlock()
+
+	if l.store != nil {
+		// wait for an in progress snapshot before taking the last one
+		if persistDone != nil {
+			<-persistDone
+		}
+
+		// persist before closing client connections, which unregisters
+		// their producers, so that they are restored on the next start
+		l.DB.setStore(nil)
+		err := l.store.snapshot(l.DB)
+		if err != nil {
+			l.logf(LOG_ERROR, "PERSIST: failed to snapshot registrations - %s", err)
+		}
+		l.store.Close()
+	}
+
 	if l.tcpServer != nil {
 		l.tcpServer.Close()
 	}
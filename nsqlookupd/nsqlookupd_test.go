@@ -1,8 +1,15 @@
 package nsqlookupd
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -357,3 +364,751 @@ func TestTombstonedNodes(t *testing.T) {
 	test.Equal(t, topicName, producers[0].Topics[0].Topic)
 	test.Equal(t, true, producers[0].Topics[0].Tombstoned)
 }
+
+func TestUntombstone(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	tcpAddr, httpAddr, nsqlookupd := mustStartLookupd(opts)
+	defer nsqlookupd.Exit()
+
+	lookupdHTTPAddrs := []string{httpAddr.String()}
+	topicName := "untombstone"
+	node := fmt.Sprintf("%s:%d", HostAddr, HTTPPort)
+
+	conn := mustConnectLookupd(t, tcpAddr)
+	defer conn.Close()
+
+	identify(t, conn)
+
+	nsq.Register(topicName, "channel1").WriteTo(conn)
+	_, err := nsq.ReadResponse(conn)
+	test.Nil(t, err)
+
+	ci := clusterinfo.New(nil, http_api.NewClient(nil, ConnectTimeout, RequestTimeout))
+	client := http_api.NewClient(nil, ConnectTimeout, RequestTimeout)
+
+	tombstones, err := ci.GetLookupdTombstones("", lookupdHTTPAddrs)
+	test.Nil(t, err)
+	test.Equal(t, 0, len(tombstones))
+
+	err = client.POSTV1(fmt.Sprintf("http://%s/topic/tombstone?topic=%s&node=%s",
+		httpAddr, topicName, node))
+	test.Nil(t, err)
+
+	tombstones, err = ci.GetLookupdTombstones(topicName, lookupdHTTPAddrs)
+	test.Nil(t, err)
+	test.Equal(t, 1, len(tombstones))
+	test.Equal(t, topicName, tombstones[0].Topic)
+	test.Equal(t, node, tombstones[0].Node)
+	test.Equal(t, true, tombstones[0].RemainingLifetime > 0)
+	test.Equal(t, true, tombstones[0].RemainingLifetime <= int64(opts.TombstoneLifetime))
+
+	var lr LookupDoc
+	err = client.GETV1(fmt.Sprintf("http://%s/lookup?topic=%s", httpAddr, topicName), &lr)
+	test.Nil(t, err)
+	test.Equal(t, 0, len(lr.Producers))
+
+	err = ci.UntombstoneNodeForTopic(topicName, node, lookupdHTTPAddrs)
+	test.Nil(t, err)
+
+	err = client.GETV1(fmt.Sprintf("http://%s/lookup?topic=%s", httpAddr, topicName), &lr)
+	test.Nil(t, err)
+	test.Equal(t, 1, len(lr.Producers))
+
+	tombstones, err = ci.GetLookupdTombstones("", lookupdHTTPAddrs)
+	test.Nil(t, err)
+	test.Equal(t, 0, len(tombstones))
+
+	err = client.POSTV1(fmt.Sprintf("http://%s/topic/untombstone?topic=%s&node=%s",
+		httpAddr, topicName, node))
+	test.NotNil(t, err)
+}
+
+func TestExitWithoutMain(t *testing.T) {
+	dataPath, err := ioutil.TempDir("", fmt.Sprintf("nsq-test-%d", time.Now().UnixNano()))
+	test.Nil(t, err)
+	defer os.RemoveAll(dataPath)
+
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.TCPAddress = "127.0.0.1:0"
+	opts.HTTPAddress = "127.0.0.1:0"
+	opts.DataPath = dataPath
+	nsqlookupd, err := New(opts)
+	test.Nil(t, err)
+
+	exited := make(chan int)
+	go func() {
+		nsqlookupd.Exit()
+		close(exited)
+	}()
+	select {
+	case <-exited:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Exit blocked without Main")
+	}
+}
+
+func TestPersistentRegistrations(t *testing.T) {
+	dataPath, err := ioutil.TempDir("", fmt.Sprintf("nsq-test-%d", time.Now().UnixNano()))
+	test.Nil(t, err)
+	defer os.RemoveAll(dataPath)
+
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.DataPath = dataPath
+	tcpAddr, httpAddr, nsqlookupd1 := mustStartLookupd(opts)
+
+	conn := mustConnectLookupd(t, tcpAddr)
+	identify(t, conn)
+	for _, topicName := range []string{"persisted", "tombstoned"} {
+		nsq.Register(topicName, "ch").WriteTo(conn)
+		_, err = nsq.ReadResponse(conn)
+		test.Nil(t, err)
+	}
+
+	client := http_api.NewClient(nil, ConnectTimeout, RequestTimeout)
+	err = client.POSTV1(fmt.Sprintf("http://%s/topic/create?topic=created", httpAddr))
+	test.Nil(t, err)
+	err = client.POSTV1(fmt.Sprintf("http://%s/topic/tombstone?topic=tombstoned&node=%s:%d",
+		httpAddr, HostAddr, HTTPPort))
+	test.Nil(t, err)
+
+	// the nsqd is still connected when nsqlookupd exits
+	nsqlookupd1.Exit()
+	conn.Close()
+
+	opts = NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.DataPath = dataPath
+	tcpAddr, httpAddr, nsqlookupd2 := mustStartLookupd(opts)
+	defer nsqlookupd2.Exit()
+
+	topics := nsqlookupd2.DB.FindRegistrations("topic", "*", "")
+	test.Equal(t, 3, len(topics))
+	channels := nsqlookupd2.DB.FindRegistrations("channel", "persisted", "*")
+	test.Equal(t, 1, len(channels))
+
+	lr := LookupDoc{}
+	err = client.GETV1(fmt.Sprintf("http://%s/lookup?topic=persisted", httpAddr), &lr)
+	test.Nil(t, err)
+	test.Equal(t, 1, len(lr.Producers))
+	test.Equal(t, HostAddr, lr.Producers[0].BroadcastAddress)
+	test.Equal(t, TCPPort, lr.Producers[0].TCPPort)
+
+	err = client.GETV1(fmt.Sprintf("http://%s/lookup?topic=tombstoned", httpAddr), &lr)
+	test.Nil(t, err)
+	test.Equal(t, 0, len(lr.Producers))
+
+	var nr struct {
+		Producers []struct {
+			Stale bool `json:"stale"`
+		} `json:"producers"`
+	}
+	err = client.GETV1(fmt.Sprintf("http://%s/nodes", httpAddr), &nr)
+	test.Nil(t, err)
+	test.Equal(t, 1, len(nr.Producers))
+	test.Equal(t, true, nr.Producers[0].Stale)
+
+	// the nsqd reconnecting replaces its restored producers, keeping the tombstone
+	conn = mustConnectLookupd(t, tcpAddr)
+	defer conn.Close()
+	identify(t, conn)
+
+	test.Equal(t, 0, len(nsqlookupd2.DB.FindProducers("topic", "persisted", "")))
+	producers := nsqlookupd2.DB.FindProducers("topic", "tombstoned", "")
+	test.Equal(t, 1, len(producers))
+	test.Equal(t, false, producers[0].IsStale())
+	test.Equal(t, true, producers[0].IsTombstoned(opts.TombstoneLifetime))
+
+	nsq.Register("persisted", "ch").WriteTo(conn)
+	_, err = nsq.ReadResponse(conn)
+	test.Nil(t, err)
+
+	err = client.GETV1(fmt.Sprintf("http://%s/nodes", httpAddr), &nr)
+	test.Nil(t, err)
+	test.Equal(t, 1, len(nr.Producers))
+	test.Equal(t, false, nr.Producers[0].Stale)
+
+	// changes since the startup snapshot are replayed from the journal
+	db := NewRegistrationDB()
+	err = newRegistrationStore(dataPath, nsqlookupd2.logf).load(db)
+	test.Nil(t, err)
+	producers = db.FindProducers("topic", "persisted", "")
+	test.Equal(t, 1, len(producers))
+	test.Equal(t, true, producers[0].IsStale())
+	test.Equal(t, conn.LocalAddr().String(), producers[0].peerInfo.id)
+}
+
+func TestGossip(t *testing.T) {
+	optsA := NewOptions()
+	optsA.Logger = test.NewTestLogger(t)
+	tcpAddrA, httpAddrA, nsqlookupdA := mustStartLookupd(optsA)
+	defer nsqlookupdA.Exit()
+
+	optsB := NewOptions()
+	optsB.Logger = test.NewTestLogger(t)
+	optsB.PeerHTTPAddresses = []string{httpAddrA.String()}
+	optsB.GossipInterval = 10 * time.Millisecond
+	_, httpAddrB, nsqlookupdB := mustStartLookupd(optsB)
+	defer nsqlookupdB.Exit()
+
+	topicName := "gossip"
+
+	// the nsqd only connects to A
+	conn := mustConnectLookupd(t, tcpAddrA)
+	identify(t, conn)
+	nsq.Register(topicName, "ch").WriteTo(conn)
+	_, err := nsq.ReadResponse(conn)
+	test.Nil(t, err)
+
+	client := http_api.NewClient(nil, ConnectTimeout, RequestTimeout)
+	err = client.POSTV1(fmt.Sprintf("http://%s/topic/create?topic=created", httpAddrA))
+	test.Nil(t, err)
+
+	lookup := func(httpAddr *net.TCPAddr) LookupDoc {
+		lr := LookupDoc{}
+		endpoint := fmt.Sprintf("http://%s/lookup?topic=%s", httpAddr, topicName)
+		client.GETV1(endpoint, &lr)
+		return lr
+	}
+	waitFor := func(cond func() bool) {
+		for i := 0; i < 200 && !cond(); i++ {
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	waitFor(func() bool { return len(lookup(httpAddrB).Producers) == 1 })
+	lr := lookup(httpAddrB)
+	test.Equal(t, 1, len(lr.Producers))
+	test.Equal(t, 1, len(lr.Channels))
+	test.Equal(t, HostAddr, lr.Producers[0].BroadcastAddress)
+
+	tr := TopicsDoc{}
+	err = client.GETV1(fmt.Sprintf("http://%s/topics", httpAddrB), &tr)
+	test.Nil(t, err)
+	test.Equal(t, 2, len(tr.Topics))
+
+	ci := clusterinfo.New(nil, client)
+	producers, err := ci.GetLookupdProducers([]string{httpAddrB.String()})
+	test.Nil(t, err)
+	test.Equal(t, 1, len(producers))
+	test.Equal(t, topicName, producers[0].Topics[0].Topic)
+
+	// a tombstone set through B applies to the nsqd connected to A
+	err = client.POSTV1(fmt.Sprintf("http://%s/topic/tombstone?topic=%s&node=%s:%d",
+		httpAddrB, topicName, HostAddr, HTTPPort))
+	test.Nil(t, err)
+	test.Equal(t, 0, len(lookup(httpAddrB).Producers))
+	producers, _ = ci.GetLookupdProducers([]string{httpAddrB.String()})
+	test.Equal(t, true, producers[0].Topics[0].Tombstoned)
+
+	// and the nsqd disconnecting from A is seen by B
+	conn.Close()
+	waitFor(func() bool {
+		producers, _ := ci.GetLookupdProducers([]string{httpAddrB.String()})
+		return len(producers) == 0
+	})
+	producers, _ = ci.GetLookupdProducers([]string{httpAddrB.String()})
+	test.Equal(t, 0, len(producers))
+}
+
+func TestGossipDelete(t *testing.T) {
+	optsA := NewOptions()
+	optsA.Logger = test.NewTestLogger(t)
+	optsA.TCPAddress = "127.0.0.1:0"
+	optsA.HTTPAddress = "127.0.0.1:0"
+	optsA.GossipInterval = 10 * time.Millisecond
+	nsqlookupdA, err := New(optsA)
+	test.Nil(t, err)
+	httpAddrA := nsqlookupdA.RealHTTPAddr()
+
+	optsB := NewOptions()
+	optsB.Logger = test.NewTestLogger(t)
+	optsB.PeerHTTPAddresses = []string{httpAddrA.String()}
+	optsB.GossipInterval = 10 * time.Millisecond
+	tcpAddrB, httpAddrB, nsqlookupdB := mustStartLookupd(optsB)
+	defer nsqlookupdB.Exit()
+
+	// A and B peer with each other
+	nsqlookupdA.gossip.peers = []*gossipPeer{{addr: httpAddrB.String()}}
+	go nsqlookupdA.Main()
+	defer nsqlookupdA.Exit()
+
+	// the nsqd only connects to B
+	conn := mustConnectLookupd(t, tcpAddrB)
+	defer conn.Close()
+	identify(t, conn)
+	for _, topicName := range []string{"deleted", "kept"} {
+		for _, channelName := range []string{"ch1", "ch2"} {
+			nsq.Register(topicName, channelName).WriteTo(conn)
+			_, err = nsq.ReadResponse(conn)
+			test.Nil(t, err)
+		}
+	}
+
+	client := http_api.NewClient(nil, ConnectTimeout, RequestTimeout)
+	topics := func(httpAddr *net.TCPAddr) []string {
+		var tr struct {
+			Topics []string `json:"topics"`
+		}
+		client.GETV1(fmt.Sprintf("http://%s/topics", httpAddr), &tr)
+		sort.Strings(tr.Topics)
+		return tr.Topics
+	}
+	channels := func(httpAddr *net.TCPAddr) []string {
+		var cr struct {
+			Channels []string `json:"channels"`
+		}
+		client.GETV1(fmt.Sprintf("http://%s/channels?topic=kept", httpAddr), &cr)
+		sort.Strings(cr.Channels)
+		return cr.Channels
+	}
+	waitFor := func(cond func() bool) {
+		for i := 0; i < 200 && !cond(); i++ {
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	waitFor(func() bool { return len(topics(httpAddrA)) == 2 && len(channels(httpAddrA)) == 2 })
+	test.Equal(t, []string{"deleted", "kept"}, topics(httpAddrA))
+
+	// deletions through A remove what was registered with B, on both
+	err = client.POSTV1(fmt.Sprintf("http://%s/topic/delete?topic=deleted", httpAddrA))
+	test.Nil(t, err)
+	err = client.POSTV1(fmt.Sprintf("http://%s/channel/delete?topic=kept&channel=ch1", httpAddrA))
+	test.Nil(t, err)
+	test.Equal(t, []string{"kept"}, topics(httpAddrA))
+	test.Equal(t, []string{"ch2"}, channels(httpAddrA))
+
+	waitFor(func() bool { return len(topics(httpAddrB)) == 1 && len(channels(httpAddrB)) == 1 })
+	test.Equal(t, []string{"kept"}, topics(httpAddrB))
+	test.Equal(t, []string{"ch2"}, channels(httpAddrB))
+
+	// and they don't come back with later syncs
+	time.Sleep(50 * time.Millisecond)
+	test.Equal(t, []string{"kept"}, topics(httpAddrA))
+	test.Equal(t, []string{"ch2"}, channels(httpAddrA))
+	lr := LookupDoc{}
+	err = client.GETV1(fmt.Sprintf("http://%s/lookup?topic=kept", httpAddrA), &lr)
+	test.Nil(t, err)
+	test.Equal(t, []interface{}{"ch2"}, lr.Channels)
+
+	// registering again after the deletion is kept
+	nsq.Register("deleted", "ch1").WriteTo(conn)
+	_, err = nsq.ReadResponse(conn)
+	test.Nil(t, err)
+	waitFor(func() bool { return len(topics(httpAddrA)) == 2 })
+	test.Equal(t, []string{"deleted", "kept"}, topics(httpAddrA))
+}
+
+func TestTopologyLookup(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	tcpAddr, httpAddr, nsqlookupd := mustStartLookupd(opts)
+	defer nsqlookupd.Exit()
+
+	topicName := "topology"
+
+	nodes := []struct {
+		port   int
+		zone   string
+		region string
+		tags   []string
+	}{
+		{1001, "a", "east", nil},
+		{1002, "b", "east", []string{"ssd"}},
+		{1003, "c", "west", []string{"ssd"}},
+	}
+	for _, n := range nodes {
+		conn := mustConnectLookupd(t, tcpAddr)
+		defer conn.Close()
+
+		ci := make(map[string]interface{})
+		ci["tcp_port"] = n.port
+		ci["http_port"] = n.port + 1000
+		ci["broadcast_address"] = HostAddr
+		ci["hostname"] = HostAddr
+		ci["version"] = NSQDVersion
+		ci["zone"] = n.zone
+		ci["region"] = n.region
+		ci["tags"] = n.tags
+		cmd, _ := nsq.Identify(ci)
+		_, err := cmd.WriteTo(conn)
+		test.Nil(t, err)
+		_, err = nsq.ReadResponse(conn)
+		test.Nil(t, err)
+
+		nsq.Register(topicName, "").WriteTo(conn)
+		_, err = nsq.ReadResponse(conn)
+		test.Nil(t, err)
+	}
+
+	client := http_api.NewClient(nil, ConnectTimeout, RequestTimeout)
+	lookup := func(query string) []int {
+		lr := LookupDoc{}
+		endpoint := fmt.Sprintf("http://%s/lookup?topic=%s&%s", httpAddr, topicName, query)
+		err := client.GETV1(endpoint, &lr)
+		test.Nil(t, err)
+		var ports []int
+		for _, p := range lr.Producers {
+			ports = append(ports, p.TCPPort)
+		}
+		return ports
+	}
+
+	test.Equal(t, 3, len(lookup("")))
+	test.Equal(t, []int{1002}, lookup("zone=b"))
+	test.Equal(t, []int{1001, 1002}, sortedInts(lookup("region=east")))
+	test.Equal(t, []int{1002, 1003}, sortedInts(lookup("tag=ssd")))
+	test.Equal(t, []int{1003}, lookup("tag=ssd&region=west"))
+	test.Equal(t, 1003, lookup("prefer_zone=c")[0])
+	ports := lookup("prefer_zone=b&prefer_region=west")
+	test.Equal(t, []int{1002, 1003, 1001}, ports)
+
+	var nr struct {
+		Producers []struct {
+			TCPPort int      `json:"tcp_port"`
+			Zone    string   `json:"zone"`
+			Region  string   `json:"region"`
+			Tags    []string `json:"tags"`
+		} `json:"producers"`
+	}
+	err := client.GETV1(fmt.Sprintf("http://%s/nodes?prefer_zone=c&tag=ssd", httpAddr), &nr)
+	test.Nil(t, err)
+	test.Equal(t, 2, len(nr.Producers))
+	test.Equal(t, 1003, nr.Producers[0].TCPPort)
+	test.Equal(t, "c", nr.Producers[0].Zone)
+	test.Equal(t, "west", nr.Producers[0].Region)
+	test.Equal(t, []string{"ssd"}, nr.Producers[0].Tags)
+}
+
+func sortedInts(a []int) []int {
+	sort.Ints(a)
+	return a
+}
+
+type WatchDoc struct {
+	Revision int64 `json:"revision"`
+	Events   []struct {
+		Revision int64     `json:"revision"`
+		Type     string    `json:"type"`
+		Topic    string    `json:"topic"`
+		Producer *PeerInfo `json:"producer"`
+	} `json:"events"`
+}
+
+func TestWatch(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	tcpAddr, httpAddr, nsqlookupd := mustStartLookupd(opts)
+	defer nsqlookupd.Exit()
+
+	topicName := "watched"
+	client := http_api.NewClient(nil, ConnectTimeout, RequestTimeout)
+	watch := func(query string) (WatchDoc, error) {
+		var wd WatchDoc
+		endpoint := fmt.Sprintf("http://%s/watch?topic=%s&%s", httpAddr, topicName, query)
+		err := client.GETV1(endpoint, &wd)
+		return wd, err
+	}
+
+	wd, err := watch("")
+	test.Nil(t, err)
+	test.Equal(t, 0, len(wd.Events))
+	revision := wd.Revision
+
+	// a watch blocks until the nsqd registers the topic
+	watchChan := make(chan WatchDoc)
+	go func() {
+		wd, _ := watch(fmt.Sprintf("revision=%d&timeout=5s", revision))
+		watchChan <- wd
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	conn := mustConnectLookupd(t, tcpAddr)
+	identify(t, conn)
+	for _, topic := range []string{topicName, "unwatched"} {
+		nsq.Register(topic, "ch").WriteTo(conn)
+		_, err = nsq.ReadResponse(conn)
+		test.Nil(t, err)
+	}
+
+	wd = <-watchChan
+	test.Equal(t, 1, len(wd.Events))
+	test.Equal(t, "add", wd.Events[0].Type)
+	test.Equal(t, topicName, wd.Events[0].Topic)
+	test.Equal(t, TCPPort, wd.Events[0].Producer.TCPPort)
+	revision = wd.Events[0].Revision
+
+	// changes to other topics are skipped
+	wd, err = watch(fmt.Sprintf("revision=%d&timeout=10ms", revision))
+	test.Nil(t, err)
+	test.Equal(t, 0, len(wd.Events))
+	test.Equal(t, true, wd.Revision > revision)
+	revision = wd.Revision
+
+	// starting over returns the current producers
+	wd, err = watch("")
+	test.Nil(t, err)
+	test.Equal(t, 1, len(wd.Events))
+	test.Equal(t, revision, wd.Revision)
+
+	err = client.POSTV1(fmt.Sprintf("http://%s/topic/tombstone?topic=%s&node=%s:%d",
+		httpAddr, topicName, HostAddr, HTTPPort))
+	test.Nil(t, err)
+	wd, err = watch(fmt.Sprintf("revision=%d", revision))
+	test.Nil(t, err)
+	test.Equal(t, 1, len(wd.Events))
+	test.Equal(t, "tombstone", wd.Events[0].Type)
+	revision = wd.Revision
+
+	conn.Close()
+	wd, err = watch(fmt.Sprintf("revision=%d&timeout=5s", revision))
+	test.Nil(t, err)
+	test.Equal(t, 1, len(wd.Events))
+	test.Equal(t, "remove", wd.Events[0].Type)
+
+	_, err = watch("revision=1")
+	test.NotNil(t, err)
+	_, err = watch(fmt.Sprintf("revision=%d", wd.Revision+1000))
+	test.NotNil(t, err)
+}
+
+func TestLoadHints(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	tcpAddr, httpAddr, nsqlookupd := mustStartLookupd(opts)
+	defer nsqlookupd.Exit()
+
+	topicName := "loaded"
+
+	hints := []map[string]interface{}{
+		{"health": "OK", "depth": 0, "topic_depths": map[string]int64{topicName: 0}, "clients": 1},
+		{"health": "OK", "depth": 900, "topic_depths": map[string]int64{topicName: 800}, "clients": 1},
+		{"health": "NOK - disk full", "depth": 0, "clients": 0},
+	}
+	for i, h := range hints {
+		conn := mustConnectLookupd(t, tcpAddr)
+		defer conn.Close()
+
+		ci := make(map[string]interface{})
+		ci["tcp_port"] = 4000 + i
+		ci["http_port"] = 5000 + i
+		ci["broadcast_address"] = HostAddr
+		ci["hostname"] = HostAddr
+		ci["version"] = NSQDVersion
+		cmd, _ := nsq.Identify(ci)
+		_, err := cmd.WriteTo(conn)
+		test.Nil(t, err)
+		resp, err := nsq.ReadResponse(conn)
+		test.Nil(t, err)
+		var info struct {
+			LoadHints bool `json:"load_hints"`
+		}
+		json.Unmarshal(resp, &info)
+		test.Equal(t, true, info.LoadHints)
+
+		nsq.Register(topicName, "").WriteTo(conn)
+		_, err = nsq.ReadResponse(conn)
+		test.Nil(t, err)
+
+		body, _ := json.Marshal(h)
+		cmd = &nsq.Command{Name: []byte("LOAD"), Body: body}
+		_, err = cmd.WriteTo(conn)
+		test.Nil(t, err)
+		resp, err = nsq.ReadResponse(conn)
+		test.Nil(t, err)
+		test.Equal(t, []byte("OK"), resp)
+	}
+
+	type weightedDoc struct {
+		Producers []struct {
+			TCPPort int `json:"tcp_port"`
+			Weight  int `json:"weight"`
+			Load    struct {
+				Health     string `json:"health"`
+				TopicDepth int64  `json:"topic_depth"`
+			} `json:"load"`
+		} `json:"producers"`
+	}
+	client := http_api.NewClient(nil, ConnectTimeout, RequestTimeout)
+
+	var lr weightedDoc
+	err := client.GETV1(fmt.Sprintf("http://%s/lookup?topic=%s", httpAddr, topicName), &lr)
+	test.Nil(t, err)
+	test.Equal(t, 3, len(lr.Producers))
+	weights := make(map[int]int)
+	for _, p := range lr.Producers {
+		weights[p.TCPPort] = p.Weight
+		if p.TCPPort == 4001 {
+			test.Equal(t, int64(800), p.Load.TopicDepth)
+		}
+	}
+	test.Equal(t, 100, weights[4000])
+	test.Equal(t, 50, weights[4001])
+	test.Equal(t, 0, weights[4002])
+
+	err = client.GETV1(fmt.Sprintf("http://%s/lookup?topic=%s&healthy_only=true", httpAddr, topicName), &lr)
+	test.Nil(t, err)
+	test.Equal(t, 2, len(lr.Producers))
+}
+
+func TestPublishers(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	tcpAddr, httpAddr, nsqlookupd := mustStartLookupd(opts)
+	defer nsqlookupd.Exit()
+
+	topicName := "published"
+
+	nodes := []struct {
+		registered bool
+		hints      map[string]interface{}
+	}{
+		{false, map[string]interface{}{"health": "OK"}},
+		{true, map[string]interface{}{"health": "OK", "topic_depths": map[string]int64{topicName: 800}}},
+		{true, map[string]interface{}{"health": "NOK - disk full"}},
+		{true, map[string]interface{}{"health": "OK"}},
+		{true, map[string]interface{}{"health": "OK"}},
+	}
+	for i, n := range nodes {
+		conn := mustConnectLookupd(t, tcpAddr)
+		defer conn.Close()
+
+		ci := make(map[string]interface{})
+		ci["tcp_port"] = 4000 + i
+		ci["http_port"] = 5000 + i
+		ci["broadcast_address"] = HostAddr
+		ci["hostname"] = HostAddr
+		ci["version"] = NSQDVersion
+		cmd, _ := nsq.Identify(ci)
+		_, err := cmd.WriteTo(conn)
+		test.Nil(t, err)
+		_, err = nsq.ReadResponse(conn)
+		test.Nil(t, err)
+
+		if n.registered {
+			nsq.Register(topicName, "").WriteTo(conn)
+			_, err = nsq.ReadResponse(conn)
+			test.Nil(t, err)
+		}
+
+		body, _ := json.Marshal(n.hints)
+		cmd = &nsq.Command{Name: []byte("LOAD"), Body: body}
+		_, err = cmd.WriteTo(conn)
+		test.Nil(t, err)
+		_, err = nsq.ReadResponse(conn)
+		test.Nil(t, err)
+	}
+
+	client := http_api.NewClient(nil, ConnectTimeout, RequestTimeout)
+
+	endpoint := fmt.Sprintf("http://%s/topic/tombstone?topic=%s&node=%s:%d",
+		httpAddr, topicName, HostAddr, 5003)
+	err := client.POSTV1(endpoint)
+	test.Nil(t, err)
+
+	var pr struct {
+		Producers []struct {
+			TCPPort  int  `json:"tcp_port"`
+			HasTopic bool `json:"has_topic"`
+			Weight   int  `json:"weight"`
+		} `json:"producers"`
+	}
+	err = client.GETV1(fmt.Sprintf("http://%s/publishers?topic=%s", httpAddr, topicName), &pr)
+	test.Nil(t, err)
+	test.Equal(t, 3, len(pr.Producers))
+	test.Equal(t, 4004, pr.Producers[0].TCPPort)
+	test.Equal(t, true, pr.Producers[0].HasTopic)
+	test.Equal(t, 4001, pr.Producers[1].TCPPort)
+	test.Equal(t, true, pr.Producers[1].HasTopic)
+	test.Equal(t, 4000, pr.Producers[2].TCPPort)
+	test.Equal(t, false, pr.Producers[2].HasTopic)
+
+	err = client.GETV1(fmt.Sprintf("http://%s/publishers", httpAddr), &pr)
+	test.NotNil(t, err)
+}
+
+func TestRegistrationEvents(t *testing.T) {
+	var mtx sync.Mutex
+	var events []registrationEvent
+	var failed bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		// fail the first delivery to exercise retries
+		if !failed {
+			failed = true
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var e registrationEvent
+		err := json.NewDecoder(req.Body).Decode(&e)
+		test.Nil(t, err)
+		events = append(events, e)
+	}))
+	defer srv.Close()
+
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.EventWebhookURLs = []string{srv.URL}
+	opts.EventRetryBackoff = 10 * time.Millisecond
+	tcpAddr, httpAddr, nsqlookupd := mustStartLookupd(opts)
+	defer nsqlookupd.Exit()
+
+	topicName := "registration_events"
+
+	conn := mustConnectLookupd(t, tcpAddr)
+	defer conn.Close()
+
+	identify(t, conn)
+
+	nsq.Register(topicName, "channel1").WriteTo(conn)
+	_, err := nsq.ReadResponse(conn)
+	test.Nil(t, err)
+
+	endpoint := fmt.Sprintf("http://%s/topic/tombstone?topic=%s&node=%s:%d",
+		httpAddr, topicName, HostAddr, HTTPPort)
+	err = http_api.NewClient(nil, ConnectTimeout, RequestTimeout).POSTV1(endpoint)
+	test.Nil(t, err)
+
+	topicEvents := func() []registrationEvent {
+		mtx.Lock()
+		defer mtx.Unlock()
+		var results []registrationEvent
+		for _, e := range events {
+			if e.Registration.Key == topicName {
+				results = append(results, e)
+			}
+		}
+		return results
+	}
+	for i := 0; i < 100 && len(topicEvents()) < 5; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// events of a topic and its channels are delivered in order
+	te := topicEvents()
+	test.Equal(t, 5, len(te))
+	expected := []struct {
+		typ     string
+		subkey  string
+		hasPeer bool
+	}{
+		{opAddRegistration, "channel1", false},
+		{opAddProducer, "channel1", true},
+		{opAddRegistration, "", false},
+		{opAddProducer, "", true},
+		{opTombstone, "", true},
+	}
+	for i, e := range te {
+		test.Equal(t, expected[i].typ, e.Type)
+		test.Equal(t, expected[i].subkey, e.Registration.SubKey)
+		test.Equal(t, expected[i].hasPeer, e.Producer != nil)
+		if i > 0 {
+			test.Equal(t, true, e.Sequence > te[i-1].Sequence)
+		}
+	}
+	test.Equal(t, HostAddr, te[1].Producer.BroadcastAddress)
+	test.Equal(t, fmt.Sprintf("%s:%d", opts.BroadcastAddress, httpAddr.Port), te[0].Source)
+}
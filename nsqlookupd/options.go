@@ -11,14 +11,38 @@ import (
 type Options struct {
 	LogLevel  lg.LogLevel `flag:"log-level"`
 	LogPrefix string      `flag:"log-prefix"`
+	LogFormat string      `flag:"log-format"`
 	Logger    Logger
 
 	TCPAddress       string `flag:"tcp-address"`
 	HTTPAddress      string `flag:"http-address"`
 	BroadcastAddress string `flag:"broadcast-address"`
 
-	InactiveProducerTimeout time.Duration `flag:"inactive-producer-timeout"`
-	TombstoneLifetime       time.Duration `flag:"tombstone-lifetime"`
+	InactiveProducerTimeout   time.Duration `flag:"inactive-producer-timeout"`
+	TombstoneLifetime         time.Duration `flag:"tombstone-lifetime"`
+	MetadataTombstoneLifetime time.Duration `flag:"metadata-tombstone-lifetime"`
+
+	DataPath         string        `flag:"data-path"`
+	SnapshotInterval time.Duration `flag:"snapshot-interval"`
+
+	PeerHTTPAddresses []string      `flag:"peer-http-address" cfg:"peer_http_addresses"`
+	PeerAuthSecret    string        `flag:"peer-auth-secret"`
+	GossipInterval    time.Duration `flag:"gossip-interval"`
+
+	AuthHTTPAddresses []string      `flag:"auth-http-address" cfg:"auth_http_addresses"`
+	AuthPolicyFile    string        `flag:"auth-policy-file"`
+	AuthPolicyRefresh time.Duration `flag:"auth-policy-refresh"`
+	AuthReadRequired  bool          `flag:"auth-read-required"`
+
+	EventWebhookURLs     []string      `flag:"event-webhook-url" cfg:"event_webhook_urls"`
+	EventNSQDHTTPAddress string        `flag:"event-nsqd-http-address"`
+	EventTopic           string        `flag:"event-topic"`
+	EventQueueSize       int           `flag:"event-queue-size"`
+	EventMaxAttempts     int           `flag:"event-max-attempts"`
+	EventRetryBackoff    time.Duration `flag:"event-retry-backoff"`
+
+	HTTPClientConnectTimeout time.Duration `flag:"http-client-connect-timeout"`
+	HTTPClientRequestTimeout time.Duration `flag:"http-client-request-timeout"`
 }
 
 func NewOptions() *Options {
@@ -29,12 +53,31 @@ func NewOptions() *Options {
 
 	return &Options{
 		LogPrefix:        "[nsqlookupd] ",
+		LogFormat:        "text",
 		LogLevel:         lg.INFO,
 		TCPAddress:       "0.0.0.0:4160",
 		HTTPAddress:      "0.0.0.0:4161",
 		BroadcastAddress: hostname,
 
-		InactiveProducerTimeout: 300 * time.Second,
-		TombstoneLifetime:       45 * time.Second,
+		InactiveProducerTimeout:   300 * time.Second,
+		TombstoneLifetime:         45 * time.Second,
+		MetadataTombstoneLifetime: 24 * time.Hour,
+
+		SnapshotInterval: 60 * time.Second,
+
+		PeerHTTPAddresses: make([]string, 0),
+		GossipInterval:    5 * time.Second,
+
+		AuthHTTPAddresses: make([]string, 0),
+		AuthPolicyRefresh: 10 * time.Second,
+
+		EventWebhookURLs:  make([]string, 0),
+		EventTopic:        "nsqlookupd_events",
+		EventQueueSize:    16384,
+		EventMaxAttempts:  10,
+		EventRetryBackoff: time.Second,
+
+		HTTPClientConnectTimeout: 2 * time.Second,
+		HTTPClientRequestTimeout: 5 * time.Second,
 	}
 }
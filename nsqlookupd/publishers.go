@@ -0,0 +1,67 @@
+package nsqlookupd
+
+import (
+	"sort"
+)
+
+// publisher is an nsqd suitable for publishing to a topic, as returned by
+// /publishers
+type publisher struct {
+	*weightedProducer
+	HasTopic bool `json:"has_topic"`
+}
+
+// findPublishers ranks the healthy nodes a client can publish topic to.
+//
+// Tombstoned, stale and unhealthy nodes are left out. The others are ordered
+// by the topology preferences of q, then nodes already hosting the topic
+// before those that would have to create it, then by weight.
+func (s *httpServer) findPublishers(topic string, q topologyQuery) []*publisher {
+	opts := s.nsqlookupd.opts
+
+	topicProducers := s.nsqlookupd.gossip.findProducers("topic", topic, "")
+	nodes := s.nsqlookupd.gossip.findProducers("client", "", "").FilterByActive(
+		opts.InactiveProducerTimeout, 0)
+	nodes = nodes.filterByTopology(q)
+
+	candidates := Producers{}
+	hasTopic := make(map[*PeerInfo]bool)
+	for _, p := range nodes {
+		if p.IsStale() {
+			continue
+		}
+		for _, tp := range topicProducers {
+			if !tp.peerInfo.isSameNode(p.peerInfo) {
+				continue
+			}
+			if tp.IsTombstoned(opts.TombstoneLifetime) {
+				goto skip
+			}
+			hasTopic[p.peerInfo] = true
+		}
+		candidates = append(candidates, p)
+	skip:
+	}
+
+	publishers := make([]*publisher, 0, len(candidates))
+	for _, w := range candidates.weigh(topic) {
+		if !w.isHealthy() {
+			continue
+		}
+		publishers = append(publishers, &publisher{
+			weightedProducer: w,
+			HasTopic:         hasTopic[w.PeerInfo],
+		})
+	}
+	sort.SliceStable(publishers, func(i, j int) bool {
+		a, b := publishers[i], publishers[j]
+		if ra, rb := q.rank(a.PeerInfo), q.rank(b.PeerInfo); ra != rb {
+			return ra < rb
+		}
+		if a.HasTopic != b.HasTopic {
+			return a.HasTopic
+		}
+		return a.Weight > b.Weight
+	})
+	return publishers
+}
@@ -10,24 +10,38 @@ import (
 type RegistrationDB struct {
 	sync.RWMutex
 	registrationMap map[Registration]ProducerMap
+	store           *registrationStore
+	watch           *watchLog
+	events          *eventEmitter
 }
 
 type Registration struct {
-	Category string
-	Key      string
-	SubKey   string
+	Category string `json:"category"`
+	Key      string `json:"key"`
+	SubKey   string `json:"subkey"`
 }
 type Registrations []Registration
 
 type PeerInfo struct {
-	lastUpdate       int64
-	id               string
+	lastUpdate int64
+	id         string
+	// stale peers were restored from disk and have not been
+	// confirmed by their nsqd reconnecting
+	stale bool
+	// the *LoadHints last reported by the nsqd
+	loadHints atomic.Value
+
 	RemoteAddress    string `json:"remote_address"`
 	Hostname         string `json:"hostname"`
 	BroadcastAddress string `json:"broadcast_address"`
 	TCPPort          int    `json:"tcp_port"`
 	HTTPPort         int    `json:"http_port"`
 	Version          string `json:"version"`
+
+	Zone   string   `json:"zone,omitempty"`
+	Region string   `json:"region,omitempty"`
+	Rack   string   `json:"rack,omitempty"`
+	Tags   []string `json:"tags,omitempty"`
 }
 
 type Producer struct {
@@ -48,16 +62,66 @@ func (p *Producer) Tombstone() {
 	p.tombstonedAt = time.Now()
 }
 
+func (p *Producer) Untombstone() {
+	p.tombstoned = false
+	p.tombstonedAt = time.Time{}
+}
+
 func (p *Producer) IsTombstoned(lifetime time.Duration) bool {
 	return p.tombstoned && time.Now().Sub(p.tombstonedAt) < lifetime
 }
 
+func (p *Producer) IsStale() bool {
+	return p.peerInfo.stale
+}
+
+// isSameNode reports whether two peers describe the same nsqd
+func (p *PeerInfo) isSameNode(other *PeerInfo) bool {
+	return p.BroadcastAddress == other.BroadcastAddress &&
+		p.TCPPort == other.TCPPort && p.HTTPPort == other.HTTPPort
+}
+
 func NewRegistrationDB() *RegistrationDB {
 	return &RegistrationDB{
 		registrationMap: make(map[Registration]ProducerMap),
 	}
 }
 
+// journal records a mutation when persistence is enabled, callers hold the write lock
+func (r *RegistrationDB) journal(e *journalEntry) {
+	if r.store == nil {
+		return
+	}
+	r.store.append(e)
+}
+
+// notify tells watchers of a change to the producers of a topic, callers hold the write lock
+func (r *RegistrationDB) notify(typ string, k Registration, p *Producer) {
+	if r.watch == nil || k.Category != "topic" {
+		return
+	}
+	r.watch.append(typ, k.Key, p.peerInfo)
+}
+
+// emit sends a registration event when event delivery is enabled, callers hold the write lock
+func (r *RegistrationDB) emit(op string, k Registration, p *Producer) {
+	if r.events == nil {
+		return
+	}
+	var peerInfo *PeerInfo
+	if p != nil {
+		peerInfo = p.peerInfo
+	}
+	r.events.emit(op, k, peerInfo)
+}
+
+// setStore starts (or, with nil, stops) journaling mutations to s
+func (r *RegistrationDB) setStore(s *registrationStore) {
+	r.Lock()
+	r.store = s
+	r.Unlock()
+}
+
 // add a registration key
 func (r *RegistrationDB) AddRegistration(k Registration) {
 	r.Lock()
@@ -65,6 +129,8 @@ func (r *RegistrationDB) AddRegistration(k Registration) {
 	_, ok := r.registrationMap[k]
 	if !ok {
 		r.registrationMap[k] = make(map[string]*Producer)
+		r.journal(&journalEntry{Op: opAddRegistration, Registration: k})
+		r.emit(opAddRegistration, k, nil)
 	}
 }
 
@@ -75,11 +141,15 @@ func (r *RegistrationDB) AddProducer(k Registration, p *Producer) bool {
 	_, ok := r.registrationMap[k]
 	if !ok {
 		r.registrationMap[k] = make(map[string]*Producer)
+		r.emit(opAddRegistration, k, nil)
 	}
 	producers := r.registrationMap[k]
 	_, found := producers[p.peerInfo.id]
 	if found == false {
 		producers[p.peerInfo.id] = p
+		r.journal(&journalEntry{Op: opAddProducer, Registration: k, Producer: newPersistedProducer(p)})
+		r.emit(opAddProducer, k, p)
+		r.notify(watchAdd, k, p)
 	}
 	return !found
 }
@@ -93,8 +163,11 @@ func (r *RegistrationDB) RemoveProducer(k Registration, id string) (bool, int) {
 		return false, 0
 	}
 	removed := false
-	if _, exists := producers[id]; exists {
+	if p, exists := producers[id]; exists {
 		removed = true
+		r.journal(&journalEntry{Op: opRemoveProducer, Registration: k, ID: id})
+		r.emit(opRemoveProducer, k, p)
+		r.notify(watchRemove, k, p)
 	}
 
 	// Note: this leaves keys in the DB even if they have empty lists
@@ -106,7 +179,95 @@ func (r *RegistrationDB) RemoveProducer(k Registration, id string) (bool, int) {
 func (r *RegistrationDB) RemoveRegistration(k Registration) {
 	r.Lock()
 	defer r.Unlock()
-	delete(r.registrationMap, k)
+	if producers, ok := r.registrationMap[k]; ok {
+		delete(r.registrationMap, k)
+		r.journal(&journalEntry{Op: opRemoveRegistration, Registration: k})
+		r.emit(opRemoveRegistration, k, nil)
+		for _, p := range producers {
+			r.notify(watchRemove, k, p)
+		}
+	}
+}
+
+// tombstone a producer of a registration
+func (r *RegistrationDB) TombstoneProducer(k Registration, p *Producer) {
+	r.Lock()
+	defer r.Unlock()
+	p.Tombstone()
+	if _, ok := r.registrationMap[k][p.peerInfo.id]; ok {
+		r.journal(&journalEntry{Op: opTombstone, Registration: k, ID: p.peerInfo.id,
+			TombstonedAt: p.tombstonedAt.UnixNano()})
+		r.emit(opTombstone, k, p)
+		r.notify(watchTombstone, k, p)
+	}
+}
+
+// clear the tombstone of a producer of a registration
+func (r *RegistrationDB) UntombstoneProducer(k Registration, p *Producer) {
+	r.Lock()
+	defer r.Unlock()
+	p.Untombstone()
+	if _, ok := r.registrationMap[k][p.peerInfo.id]; ok {
+		r.journal(&journalEntry{Op: opUntombstone, Registration: k, ID: p.peerInfo.id})
+		r.emit(opUntombstone, k, p)
+		r.notify(watchUntombstone, k, p)
+	}
+}
+
+// ReplaceStaleProducers drops the restored producers describing the same nsqd
+// as peerInfo, which has just IDENTIFYed.
+//
+// Tombstoned producers are carried over to the new peer so the tombstone
+// survives the nsqd re-registering its topics, the rest are re-registered
+// by the nsqd itself.
+func (r *RegistrationDB) ReplaceStaleProducers(peerInfo *PeerInfo) int {
+	r.Lock()
+	defer r.Unlock()
+	replaced := 0
+	for k, producers := range r.registrationMap {
+		for id, p := range producers {
+			if !p.peerInfo.stale || !p.peerInfo.isSameNode(peerInfo) {
+				continue
+			}
+			delete(producers, id)
+			r.journal(&journalEntry{Op: opRemoveProducer, Registration: k, ID: id})
+			r.emit(opRemoveProducer, k, p)
+			r.notify(watchRemove, k, p)
+			if p.tombstoned {
+				np := &Producer{
+					peerInfo:     peerInfo,
+					tombstoned:   true,
+					tombstonedAt: p.tombstonedAt,
+				}
+				producers[peerInfo.id] = np
+				r.journal(&journalEntry{Op: opAddProducer, Registration: k, Producer: newPersistedProducer(np)})
+				r.emit(opAddProducer, k, np)
+			}
+			replaced++
+		}
+	}
+	return replaced
+}
+
+// RemoveStaleProducers drops restored producers whose nsqd has not
+// reconnected since before the given time
+func (r *RegistrationDB) RemoveStaleProducers(before time.Time) int {
+	r.Lock()
+	defer r.Unlock()
+	removed := 0
+	for k, producers := range r.registrationMap {
+		for id, p := range producers {
+			if !p.peerInfo.stale || atomic.LoadInt64(&p.peerInfo.lastUpdate) >= before.UnixNano() {
+				continue
+			}
+			delete(producers, id)
+			r.journal(&journalEntry{Op: opRemoveProducer, Registration: k, ID: id})
+			r.emit(opRemoveProducer, k, p)
+			r.notify(watchRemove, k, p)
+			removed++
+		}
+	}
+	return removed
 }
 
 func (r *RegistrationDB) needFilter(key string, subkey string) bool {
@@ -12,9 +12,12 @@ import (
 func TestRegistrationDB(t *testing.T) {
 	sec30 := 30 * time.Second
 	beginningOfTime := time.Unix(1348797047, 0)
-	pi1 := &PeerInfo{beginningOfTime.UnixNano(), "1", "remote_addr:1", "host", "b_addr", 1, 2, "v1"}
-	pi2 := &PeerInfo{beginningOfTime.UnixNano(), "2", "remote_addr:2", "host", "b_addr", 2, 3, "v1"}
-	pi3 := &PeerInfo{beginningOfTime.UnixNano(), "3", "remote_addr:3", "host", "b_addr", 3, 4, "v1"}
+	pi1 := &PeerInfo{lastUpdate: beginningOfTime.UnixNano(), id: "1", RemoteAddress: "remote_addr:1",
+		Hostname: "host", BroadcastAddress: "b_addr", TCPPort: 1, HTTPPort: 2, Version: "v1"}
+	pi2 := &PeerInfo{lastUpdate: beginningOfTime.UnixNano(), id: "2", RemoteAddress: "remote_addr:2",
+		Hostname: "host", BroadcastAddress: "b_addr", TCPPort: 2, HTTPPort: 3, Version: "v1"}
+	pi3 := &PeerInfo{lastUpdate: beginningOfTime.UnixNano(), id: "3", RemoteAddress: "remote_addr:3",
+		Hostname: "host", BroadcastAddress: "b_addr", TCPPort: 3, HTTPPort: 4, Version: "v1"}
 	p1 := &Producer{pi1, false, beginningOfTime}
 	p2 := &Producer{pi2, false, beginningOfTime}
 	p3 := &Producer{pi3, false, beginningOfTime}
@@ -0,0 +1,310 @@
+package nsqlookupd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/nsqio/nsq/internal/lg"
+	"github.com/nsqio/nsq/internal/version"
+)
+
+// journal operations, one per RegistrationDB mutation
+const (
+	opAddRegistration    = "add_registration"
+	opRemoveRegistration = "remove_registration"
+	opAddProducer        = "add_producer"
+	opRemoveProducer     = "remove_producer"
+	opTombstone          = "tombstone"
+	opUntombstone        = "untombstone"
+)
+
+type persistedProducer struct {
+	ID           string     `json:"id"`
+	PeerInfo     *PeerInfo  `json:"peer_info"`
+	LastUpdate   int64      `json:"last_update"`
+	Stale        bool       `json:"stale,omitempty"`
+	TombstonedAt int64      `json:"tombstoned_at,omitempty"`
+	LoadHints    *LoadHints `json:"load_hints,omitempty"`
+}
+
+type journalEntry struct {
+	Op           string             `json:"op"`
+	Registration Registration       `json:"registration"`
+	Producer     *persistedProducer `json:"producer,omitempty"`
+	ID           string             `json:"id,omitempty"`
+	TombstonedAt int64              `json:"tombstoned_at,omitempty"`
+}
+
+type snapshotRegistration struct {
+	Registration
+	Producers []*persistedProducer `json:"producers"`
+}
+
+type snapshot struct {
+	Version       string                 `json:"version"`
+	Registrations []snapshotRegistration `json:"registrations"`
+}
+
+func newPersistedProducer(p *Producer) *persistedProducer {
+	pp := &persistedProducer{
+		ID:         p.peerInfo.id,
+		PeerInfo:   p.peerInfo,
+		LastUpdate: atomic.LoadInt64(&p.peerInfo.lastUpdate),
+		Stale:      p.peerInfo.stale,
+		LoadHints:  p.peerInfo.getLoadHints(),
+	}
+	if p.tombstoned {
+		pp.TombstonedAt = p.tombstonedAt.UnixNano()
+	}
+	return pp
+}
+
+// snapshot returns the full contents of the DB
+func (r *RegistrationDB) snapshot() *snapshot {
+	r.RLock()
+	defer r.RUnlock()
+	return r.snapshotLocked()
+}
+
+func (r *RegistrationDB) snapshotLocked() *snapshot {
+	snap := &snapshot{
+		Version:       version.Binary,
+		Registrations: make([]snapshotRegistration, 0, len(r.registrationMap)),
+	}
+	for k, producers := range r.registrationMap {
+		sr := snapshotRegistration{
+			Registration: k,
+			Producers:    make([]*persistedProducer, 0, len(producers)),
+		}
+		for _, p := range producers {
+			sr.Producers = append(sr.Producers, newPersistedProducer(p))
+		}
+		snap.Registrations = append(snap.Registrations, sr)
+	}
+	return snap
+}
+
+// registrationStore persists a RegistrationDB as a snapshot plus a journal
+// of the mutations made since that snapshot was taken
+type registrationStore struct {
+	sync.Mutex
+	snapshotFile string
+	journalFile  string
+	journal      *os.File
+	entries      int64
+	logf         lg.AppLogFunc
+}
+
+func newRegistrationStore(dataPath string, logf lg.AppLogFunc) *registrationStore {
+	return &registrationStore{
+		snapshotFile: path.Join(dataPath, "nsqlookupd.dat"),
+		journalFile:  path.Join(dataPath, "nsqlookupd.journal"),
+		logf:         logf,
+	}
+}
+
+// load restores the snapshot and replays the journal into db.
+//
+// Restored producers are marked stale and considered alive from now, they
+// are replaced when the nsqd they describe reconnects and IDENTIFYs.
+func (s *registrationStore) load(db *RegistrationDB) error {
+	peers := make(map[string]*PeerInfo)
+	addProducer := func(k Registration, pp *persistedProducer) {
+		if pp == nil || pp.PeerInfo == nil {
+			return
+		}
+		peerInfo, ok := peers[pp.ID]
+		if !ok {
+			peerInfo = pp.PeerInfo
+			peerInfo.id = pp.ID
+			peerInfo.stale = true
+			peers[pp.ID] = peerInfo
+		}
+		p := &Producer{peerInfo: peerInfo}
+		if pp.TombstonedAt != 0 {
+			p.tombstoned = true
+			p.tombstonedAt = time.Unix(0, pp.TombstonedAt)
+		}
+		if _, ok := db.registrationMap[k]; !ok {
+			db.registrationMap[k] = make(ProducerMap)
+		}
+		db.registrationMap[k][pp.ID] = p
+	}
+
+	db.Lock()
+	defer db.Unlock()
+
+	data, err := ioutil.ReadFile(s.snapshotFile)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read registrations from %s - %s", s.snapshotFile, err)
+	}
+	if data != nil {
+		var snap snapshot
+		err = json.Unmarshal(data, &snap)
+		if err != nil {
+			return fmt.Errorf("failed to parse registrations in %s - %s", s.snapshotFile, err)
+		}
+		for _, r := range snap.Registrations {
+			if _, ok := db.registrationMap[r.Registration]; !ok {
+				db.registrationMap[r.Registration] = make(ProducerMap)
+			}
+			for _, pp := range r.Producers {
+				addProducer(r.Registration, pp)
+			}
+		}
+	}
+
+	f, err := os.Open(s.journalFile)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read journal %s - %s", s.journalFile, err)
+	}
+	if f != nil {
+		defer f.Close()
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		var replayed int
+		for scanner.Scan() {
+			var e journalEntry
+			err := json.Unmarshal(scanner.Bytes(), &e)
+			if err != nil {
+				// a crash can leave a partially written final entry
+				s.logf(LOG_WARN, "PERSIST: stopping journal replay at entry %d - %s", replayed, err)
+				break
+			}
+			switch e.Op {
+			case opAddRegistration:
+				if _, ok := db.registrationMap[e.Registration]; !ok {
+					db.registrationMap[e.Registration] = make(ProducerMap)
+				}
+			case opRemoveRegistration:
+				delete(db.registrationMap, e.Registration)
+			case opAddProducer:
+				addProducer(e.Registration, e.Producer)
+			case opRemoveProducer:
+				if producers, ok := db.registrationMap[e.Registration]; ok {
+					delete(producers, e.ID)
+				}
+			case opTombstone:
+				if p, ok := db.registrationMap[e.Registration][e.ID]; ok {
+					p.tombstoned = true
+					p.tombstonedAt = time.Unix(0, e.TombstonedAt)
+				}
+			case opUntombstone:
+				if p, ok := db.registrationMap[e.Registration][e.ID]; ok {
+					p.Untombstone()
+				}
+			default:
+				s.logf(LOG_WARN, "PERSIST: skipping unknown journal op %s", e.Op)
+			}
+			replayed++
+		}
+		if err := scanner.Err(); err != nil {
+			s.logf(LOG_WARN, "PERSIST: stopping journal replay at entry %d - %s", replayed, err)
+		}
+	}
+
+	now := time.Now().UnixNano()
+	for _, peerInfo := range peers {
+		atomic.StoreInt64(&peerInfo.lastUpdate, now)
+	}
+
+	s.logf(LOG_INFO, "PERSIST: restored %d registrations from %d producers",
+		len(db.registrationMap), len(peers))
+
+	return nil
+}
+
+// open prepares the journal for appending, it must be called after load
+func (s *registrationStore) open() error {
+	var err error
+	s.journal, err = os.OpenFile(s.journalFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open journal %s - %s", s.journalFile, err)
+	}
+	return nil
+}
+
+// append writes e to the journal, callers hold the RegistrationDB write lock
+// so entries are recorded in the order they are applied
+func (s *registrationStore) append(e *journalEntry) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		s.logf(LOG_ERROR, "PERSIST: failed to marshal journal entry - %s", err)
+		return
+	}
+	_, err = s.journal.Write(append(data, '\n'))
+	if err != nil {
+		s.logf(LOG_ERROR, "PERSIST: failed to write journal entry - %s", err)
+		return
+	}
+	atomic.AddInt64(&s.entries, 1)
+}
+
+// snapshot writes the full contents of db and truncates the journal
+func (s *registrationStore) snapshot(db *RegistrationDB) error {
+	s.Lock()
+	defer s.Unlock()
+
+	// hold the lock until the journal is truncated so no entry is lost
+	db.RLock()
+	defer db.RUnlock()
+
+	data, err := json.Marshal(db.snapshotLocked())
+	if err != nil {
+		return err
+	}
+
+	tmpFileName := fmt.Sprintf("%s.%d.tmp", s.snapshotFile, rand.Int())
+	err = writeSyncFile(tmpFileName, data)
+	if err != nil {
+		return err
+	}
+	err = os.Rename(tmpFileName, s.snapshotFile)
+	if err != nil {
+		return err
+	}
+
+	if s.journal != nil {
+		err = s.journal.Truncate(0)
+		if err != nil {
+			return fmt.Errorf("failed to truncate journal %s - %s", s.journalFile, err)
+		}
+	}
+	atomic.StoreInt64(&s.entries, 0)
+
+	return nil
+}
+
+// dirty reports whether anything has been journaled since the last snapshot
+func (s *registrationStore) dirty() bool {
+	return atomic.LoadInt64(&s.entries) > 0
+}
+
+func (s *registrationStore) Close() error {
+	if s.journal == nil {
+		return nil
+	}
+	return s.journal.Close()
+}
+
+func writeSyncFile(fn string, data []byte) error {
+	f, err := os.OpenFile(fn, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	f.Close()
+	return err
+}
@@ -0,0 +1,57 @@
+package nsqlookupd
+
+import (
+	"sort"
+	"time"
+)
+
+// tombstoneInfo is an active tombstone, as returned by /tombstones
+type tombstoneInfo struct {
+	Topic             string `json:"topic"`
+	Node              string `json:"node"`
+	Hostname          string `json:"hostname"`
+	BroadcastAddress  string `json:"broadcast_address"`
+	TCPPort           int    `json:"tcp_port"`
+	HTTPPort          int    `json:"http_port"`
+	TombstonedAt      int64  `json:"tombstoned_at"`
+	RemainingLifetime int64  `json:"remaining_lifetime"` // nanoseconds
+}
+
+// findTombstones returns the tombstones still in effect across the cluster
+// for topic, or for every topic when topic is empty, ordered by topic and
+// node
+func (s *httpServer) findTombstones(topic string) []*tombstoneInfo {
+	lifetime := s.nsqlookupd.opts.TombstoneLifetime
+
+	topics := []string{topic}
+	if topic == "" {
+		topics = s.nsqlookupd.gossip.findRegistrations("topic", "*", "").Keys()
+	}
+
+	now := time.Now()
+	tombstones := []*tombstoneInfo{}
+	for _, t := range topics {
+		for _, p := range s.nsqlookupd.gossip.findProducers("topic", t, "") {
+			if !p.IsTombstoned(lifetime) {
+				continue
+			}
+			tombstones = append(tombstones, &tombstoneInfo{
+				Topic:             t,
+				Node:              p.peerInfo.tombstoneNode(),
+				Hostname:          p.peerInfo.Hostname,
+				BroadcastAddress:  p.peerInfo.BroadcastAddress,
+				TCPPort:           p.peerInfo.TCPPort,
+				HTTPPort:          p.peerInfo.HTTPPort,
+				TombstonedAt:      p.tombstonedAt.UnixNano(),
+				RemainingLifetime: int64(lifetime - now.Sub(p.tombstonedAt)),
+			})
+		}
+	}
+	sort.Slice(tombstones, func(i, j int) bool {
+		if tombstones[i].Topic != tombstones[j].Topic {
+			return tombstones[i].Topic < tombstones[j].Topic
+		}
+		return tombstones[i].Node < tombstones[j].Node
+	})
+	return tombstones
+}
@@ -0,0 +1,107 @@
+package nsqlookupd
+
+import (
+	"sort"
+
+	"github.com/nsqio/nsq/internal/http_api"
+)
+
+// topologyQuery holds the topology filters and preferences of a /lookup or
+// /nodes request.
+//
+// Filters (zone, region, rack, tag) drop producers that don't match, while
+// preferences (prefer_zone, prefer_region, prefer_rack, prefer_tag) order
+// matching producers first, most specific label first.
+type topologyQuery struct {
+	zone   string
+	region string
+	rack   string
+	tags   []string
+
+	preferZone   string
+	preferRegion string
+	preferRack   string
+	preferTags   []string
+}
+
+func newTopologyQuery(reqParams *http_api.ReqParams) topologyQuery {
+	get := func(key string) string {
+		v, _ := reqParams.Get(key)
+		return v
+	}
+	tags, _ := reqParams.GetAll("tag")
+	preferTags, _ := reqParams.GetAll("prefer_tag")
+	return topologyQuery{
+		zone:         get("zone"),
+		region:       get("region"),
+		rack:         get("rack"),
+		tags:         tags,
+		preferZone:   get("prefer_zone"),
+		preferRegion: get("prefer_region"),
+		preferRack:   get("prefer_rack"),
+		preferTags:   preferTags,
+	}
+}
+
+func (p *PeerInfo) hasTag(tag string) bool {
+	for _, t := range p.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func (q topologyQuery) match(p *PeerInfo) bool {
+	if q.zone != "" && p.Zone != q.zone {
+		return false
+	}
+	if q.region != "" && p.Region != q.region {
+		return false
+	}
+	if q.rack != "" && p.Rack != q.rack {
+		return false
+	}
+	for _, tag := range q.tags {
+		if !p.hasTag(tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// rank orders producers by the preferences they satisfy, lower is better
+func (q topologyQuery) rank(p *PeerInfo) int {
+	rank := 0
+	if q.preferRack != "" && p.Rack != q.preferRack {
+		rank |= 8
+	}
+	if q.preferZone != "" && p.Zone != q.preferZone {
+		rank |= 4
+	}
+	if q.preferRegion != "" && p.Region != q.preferRegion {
+		rank |= 2
+	}
+	for _, tag := range q.preferTags {
+		if !p.hasTag(tag) {
+			rank |= 1
+			break
+		}
+	}
+	return rank
+}
+
+// filterByTopology returns the producers matching the filters of q, ordered
+// by its preferences
+func (pp Producers) filterByTopology(q topologyQuery) Producers {
+	results := Producers{}
+	for _, p := range pp {
+		if q.match(p.peerInfo) {
+			results = append(results, p)
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return q.rank(results[i].peerInfo) < q.rank(results[j].peerInfo)
+	})
+	return results
+}
@@ -0,0 +1,99 @@
+package nsqlookupd
+
+import (
+	"sync"
+	"time"
+)
+
+// watchHistorySize is the number of events kept for clients resuming a watch
+const watchHistorySize = 4096
+
+const (
+	watchAdd         = "add"
+	watchRemove      = "remove"
+	watchTombstone   = "tombstone"
+	watchUntombstone = "untombstone"
+)
+
+// watchEvent is a change to the producers of a topic
+type watchEvent struct {
+	Revision int64     `json:"revision"`
+	Type     string    `json:"type"`
+	Topic    string    `json:"topic"`
+	Producer *PeerInfo `json:"producer"`
+}
+
+// watchLog keeps a bounded history of producer changes, numbered by a
+// revision, and wakes watchers when new ones are appended.
+//
+// Revisions start from the startup time in microseconds so that they keep
+// increasing across restarts and a client resuming from a revision issued
+// by a previous process is told it can't be resumed.
+type watchLog struct {
+	sync.Mutex
+	revision   int64
+	events     []watchEvent // ring buffer of the last watchHistorySize events
+	next       int
+	notifyChan chan struct{}
+}
+
+func newWatchLog() *watchLog {
+	return &watchLog{
+		revision:   time.Now().UnixNano() / int64(time.Microsecond),
+		events:     make([]watchEvent, 0, watchHistorySize),
+		notifyChan: make(chan struct{}),
+	}
+}
+
+func (w *watchLog) append(typ string, topic string, peerInfo *PeerInfo) {
+	w.Lock()
+	defer w.Unlock()
+	w.revision++
+	e := watchEvent{
+		Revision: w.revision,
+		Type:     typ,
+		Topic:    topic,
+		Producer: peerInfo,
+	}
+	if len(w.events) < watchHistorySize {
+		w.events = append(w.events, e)
+	} else {
+		w.events[w.next] = e
+		w.next = (w.next + 1) % watchHistorySize
+	}
+	close(w.notifyChan)
+	w.notifyChan = make(chan struct{})
+}
+
+// current returns the latest revision
+func (w *watchLog) current() int64 {
+	w.Lock()
+	defer w.Unlock()
+	return w.revision
+}
+
+// since returns the events for topic after revision, the current revision
+// and a channel that is closed on the next append.
+//
+// ok is false when events after revision are no longer (or were never) held.
+func (w *watchLog) since(topic string, revision int64) ([]watchEvent, int64, <-chan struct{}, bool) {
+	w.Lock()
+	defer w.Unlock()
+
+	if revision > w.revision {
+		return nil, w.revision, w.notifyChan, false
+	}
+	oldest := w.revision - int64(len(w.events)) + 1
+	if revision < oldest-1 {
+		return nil, w.revision, w.notifyChan, false
+	}
+
+	events := []watchEvent{}
+	for i := 0; i < len(w.events); i++ {
+		e := w.events[(w.next+i)%len(w.events)]
+		if e.Revision > revision && e.Topic == topic {
+			events = append(events, e)
+		}
+	}
+	return events, w.revision, w.notifyChan, true
+}